@@ -0,0 +1,255 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how long before its expiry an access token is refreshed,
+// so that it doesn't expire in transit.
+const expiryDelta = 10 * time.Second
+
+// Errors
+var (
+	ErrTokenUnavailable = errors.New("access token unavailable")
+)
+
+// Token is an OAuth2 access token used to authorize the requests to the hub.
+type Token struct {
+	// AccessToken is the token that authorizes the requests.
+	AccessToken string
+
+	// TokenType is the type of the token (e.g. Bearer).
+	// An empty type is assumed to be Bearer.
+	TokenType string
+
+	// Expiry is when the token expires.
+	// A zero value means the token does not expire.
+	Expiry time.Time
+}
+
+// Valid reports if the token is set and not about to expire.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Until(t.Expiry) > expiryDelta)
+}
+
+// authorization returns the value of the Authorization header for the token.
+func (t *Token) authorization() string {
+	typ := t.TokenType
+	if typ == "" || strings.EqualFold(typ, "bearer") {
+		typ = "Bearer"
+	}
+	return typ + " " + t.AccessToken
+}
+
+// TokenSource supplies the access tokens used to authorize the requests to the hub.
+// It is modeled after the golang.org/x/oauth2 token source, which can be adapted with [TokenSourceFunc].
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenSourceFunc is an adapter to use a function as a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (*Token, error)
+
+// Token returns the token returned by the function.
+func (f TokenSourceFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a token source that always returns the given access token.
+func StaticTokenSource(accessToken string) TokenSource {
+	token := &Token{AccessToken: accessToken}
+
+	return TokenSourceFunc(func(ctx context.Context) (*Token, error) {
+		return token, nil
+	})
+}
+
+// ReuseTokenSource returns a token source that reuses a token while it is valid,
+// only asking the given source for a new one when the token is about to expire.
+func ReuseTokenSource(src TokenSource) TokenSource {
+	if s, ok := src.(*reuseTokenSource); ok {
+		return s
+	}
+	return &reuseTokenSource{src: src}
+}
+
+// reuseTokenSource caches the tokens of the underlying source.
+type reuseTokenSource struct {
+	src TokenSource
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func (s *reuseTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+
+	token, err := s.src.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = token
+	return token, nil
+}
+
+// ClientCredentials configures the OAuth2 client credentials flow (RFC 6749, section 4.4)
+// used to obtain access tokens from an authorization server, such as the one of DeepHub.
+type ClientCredentials struct {
+	// TokenURL is the token endpoint of the authorization server.
+	TokenURL string
+
+	// ClientID and ClientSecret are the credentials of the client.
+	ClientID     string
+	ClientSecret string
+
+	// Scopes optionally requested for the tokens.
+	Scopes []string
+
+	// EndpointParams are additional parameters sent to the token endpoint.
+	EndpointParams url.Values
+}
+
+// TokenSource returns a token source that requests the tokens with the given HTTP client
+// (or http.DefaultClient if nil), refreshing them when they are about to expire.
+func (cc ClientCredentials) TokenSource(client *http.Client) TokenSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return ReuseTokenSource(&clientCredentialsSource{
+		config: cc,
+		client: client,
+	})
+}
+
+// clientCredentialsSource requests a new token for each call.
+type clientCredentialsSource struct {
+	config ClientCredentials
+	client *http.Client
+}
+
+// tokenResponse is the successful response of a token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// tokenError is the error response of a token endpoint.
+type tokenError struct {
+	ErrorCode   string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (s *clientCredentialsSource) Token(ctx context.Context) (*Token, error) {
+	form := url.Values{}
+	for k, v := range s.config.EndpointParams {
+		form[k] = v
+	}
+	form.Set("grant_type", "client_credentials")
+	if len(s.config.Scopes) != 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("could not create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTokenUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTokenUnavailable, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e tokenError
+		if json.Unmarshal(body, &e) == nil && e.ErrorCode != "" {
+			return nil, fmt.Errorf("%w: %s: %s", ErrTokenUnavailable, e.ErrorCode, e.Description)
+		}
+		return nil, fmt.Errorf("%w: token endpoint responded with status %d", ErrTokenUnavailable, resp.StatusCode)
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("%w: could not decode token response: %w", ErrTokenUnavailable, err)
+	}
+
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("%w: token response without access_token", ErrTokenUnavailable)
+	}
+
+	token := &Token{
+		AccessToken: tr.AccessToken,
+		TokenType:   tr.TokenType,
+	}
+	if tr.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// token returns the access token of the client, if it was configured with a token source.
+func (c *Client) token(ctx context.Context) (*Token, error) {
+	if c.tokens == nil {
+		return nil, nil
+	}
+
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil || token.AccessToken == "" {
+		return nil, ErrTokenUnavailable
+	}
+
+	return token, nil
+}
+
+// authorize returns a copy of the websocket message carrying the access token
+// in its parameters, as required by the hub for subscriptions and published messages.
+func (c *Client) authorize(ctx context.Context, wrObj *WrapperObject) (*WrapperObject, error) {
+	if c.tokens == nil || (wrObj.Event != EventSubscribe && wrObj.Event != EventMsg) {
+		return wrObj, nil
+	}
+
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// released once written (see [Client.publish])
+	authorized := getWrapper(wrObj)
+	authorized.Params[ParamAccessToken] = token.AccessToken
+
+	return authorized, nil
+}
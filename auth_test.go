@@ -0,0 +1,216 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTokenServer returns an OAuth2 token endpoint issuing numbered tokens with the given lifetime.
+func newTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var issued atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_client","error_description":"bad credentials"}`)
+			return
+		}
+		if r.PostFormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"unsupported_grant_type"}`)
+			return
+		}
+
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":%d}`, n, expiresIn)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &issued
+}
+
+func TestClientCredentials(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reuse", func(t *testing.T) {
+		srv, issued := newTokenServer(t, 3600)
+
+		src := ClientCredentials{TokenURL: srv.URL, ClientID: "client", ClientSecret: "secret"}.TokenSource(nil)
+
+		for i := 0; i < 3; i++ {
+			token, err := src.Token(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token.AccessToken != "token-1" {
+				t.Fatalf("got token %q, want %q", token.AccessToken, "token-1")
+			}
+		}
+
+		if n := issued.Load(); n != 1 {
+			t.Errorf("issued %d tokens, want 1", n)
+		}
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		// tokens expiring within the expiry delta are refreshed on every use
+		srv, issued := newTokenServer(t, 1)
+
+		src := ClientCredentials{TokenURL: srv.URL, ClientID: "client", ClientSecret: "secret"}.TokenSource(nil)
+
+		for i := 1; i <= 2; i++ {
+			token, err := src.Token(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fmt.Sprintf("token-%d", i); token.AccessToken != want {
+				t.Fatalf("got token %q, want %q", token.AccessToken, want)
+			}
+		}
+
+		if n := issued.Load(); n != 2 {
+			t.Errorf("issued %d tokens, want 2", n)
+		}
+	})
+
+	t.Run("invalid_client", func(t *testing.T) {
+		srv, _ := newTokenServer(t, 3600)
+
+		src := ClientCredentials{TokenURL: srv.URL, ClientID: "client", ClientSecret: "wrong"}.TokenSource(nil)
+
+		if _, err := src.Token(ctx); !errors.Is(err, ErrTokenUnavailable) {
+			t.Fatalf("got error %v, want %v", err, ErrTokenUnavailable)
+		}
+	})
+}
+
+func TestTokenValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token *Token
+		valid bool
+	}{
+		{"nil", nil, false},
+		{"empty", &Token{}, false},
+		{"no_expiry", &Token{AccessToken: "t"}, true},
+		{"expired", &Token{AccessToken: "t", Expiry: time.Now().Add(-time.Minute)}, false},
+		{"expiring", &Token{AccessToken: "t", Expiry: time.Now().Add(time.Second)}, false},
+		{"valid", &Token{AccessToken: "t", Expiry: time.Now().Add(time.Hour)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.Valid(); got != tt.valid {
+				t.Errorf("Valid() = %v, want %v", got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestRESTAuthorization(t *testing.T) {
+	tokenSrv, _ := newTokenServer(t, 3600)
+
+	auth := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth <- r.Header.Get("Authorization")
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name   string
+		option ClientOption
+		want   string
+	}{
+		{"static", WithStaticToken("static"), "Bearer static"},
+		{"client_credentials", WithClientCredentials(ClientCredentials{TokenURL: tokenSrv.URL, ClientID: "client", ClientSecret: "secret"}), "Bearer token-1"},
+		{"token_source", WithTokenSource(TokenSourceFunc(func(ctx context.Context) (*Token, error) {
+			return &Token{AccessToken: "custom", TokenType: "MAC"}, nil
+		})), "MAC custom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(srv.URL, tt.option)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := c.Zones.List(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := <-auth; got != tt.want {
+				t.Errorf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unavailable", func(t *testing.T) {
+		c, err := New(srv.URL, WithTokenSource(TokenSourceFunc(func(ctx context.Context) (*Token, error) {
+			return nil, nil
+		})))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(context.Background()); !errors.Is(err, ErrTokenUnavailable) {
+			t.Fatalf("got error %v, want %v", err, ErrTokenUnavailable)
+		}
+	})
+}
+
+func TestWebsocketAuthorization(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t, WithStaticToken("secret"))
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicLocationUpdates, WithProviderID("p1"))
+		res <- err
+	}()
+
+	req := hc.expect(t, EventSubscribe)
+	if got := req.Params[ParamAccessToken]; got != "secret" {
+		t.Errorf("subscribe access_token = %q, want %q", got, "secret")
+	}
+
+	// hubs may echo the parameters, including the access token
+	ack, _ := json.Marshal(WrapperObject{Event: EventSubscribed, Topic: TopicLocationUpdates, SubscriptionID: 1, Params: req.Params})
+	hc.send(t, string(ack))
+
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Publish(context.Background(), TopicLocationUpdates, json.RawMessage(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := hc.expect(t, EventMsg)
+	if got := msg.Params[ParamAccessToken]; got != "secret" {
+		t.Errorf("message access_token = %q, want %q", got, "secret")
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for sub := range c.subs {
+		if _, ok := sub.params[ParamAccessToken]; ok {
+			t.Error("access token stored in the subscription parameters")
+		}
+	}
+}
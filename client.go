@@ -6,12 +6,13 @@ package omlox
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	"nhooyr.io/websocket"
@@ -28,8 +29,20 @@ type Client struct {
 
 	client *http.Client
 
+	// supplies the access tokens, if authorization is configured.
+	tokens TokenSource
+
+	// sends the REST requests through the middlewares.
+	roundTrip RoundTripFunc
+
+	// functions called around every REST request.
+	requestHandlers  []func(*http.Request)
+	responseHandlers []func(*http.Request, *http.Response, error)
+
 	Trackables TrackablesAPI
 	Providers  ProvidersAPI
+	Zones      ZonesAPI
+	Fences     FencesAPI
 
 	// websockets client fields
 
@@ -37,18 +50,46 @@ type Client struct {
 	cancel context.CancelFunc
 
 	// websockets connection
-	conn   *websocket.Conn
-	closed bool
+	conn  *websocket.Conn
+	state ConnectionState
+
+	// round-trip time of the last heartbeat.
+	latency time.Duration
+
+	// functions called on every connection state change.
+	stateHandlers []func(ConnectionState)
+
+	// sequence number of the last state change, and of the last one notified to the handlers.
+	// Notifications have their own lock, so that they are delivered in order without holding c.mu.
+	stateSeq    uint64
+	notifyMu    sync.Mutex
+	notified    *sync.Cond
+	notifiedSeq uint64
+
+	// set when the client is closed by the user, so that it isn't reconnected.
+	stopped bool
 
 	// subscriptions
-	subs map[int]*Subcription
+	subs map[*Subscription]struct{}
 
-	// pending subscription awaiting for subscription ID from the server
-	// can only be one subscription per client awaiting for subscription.
-	pending chan chan struct {
-		sid int
-		err error
-	}
+	// pending subscriptions awaiting confirmation from the server, in request order.
+	pending []*pendingSub
+
+	// pending unsubscriptions awaiting confirmation from the server, by subscription ID.
+	unsubs map[int]chan error
+
+	// sequence number of the last published message by topic, to correlate asynchronous
+	// errors of the server. It has its own lock, so that publishing does not contend with c.mu.
+	pubMu     sync.Mutex
+	pubSeq    uint64
+	published map[Topic]uint64
+	lastTopic Topic
+
+	// functions called with every websocket error sent by the server.
+	errorHandlers []func(*HubError)
+
+	// signals that messages were spooled (buffered).
+	spooled chan struct{}
 }
 
 // New returns a new client decorated with the given configuration options
@@ -73,21 +114,36 @@ func newClient(addr string, configuration ClientConfiguration) (*Client, error)
 		return nil, err
 	}
 
+	// configured or default HTTP client, with the TLS configuration (if any)
+	httpClient, err := withTLSConfig(configuration.HTTPClient, configuration.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	c := Client{
 		configuration: configuration,
 
-		// configured or default HTTP client
-		client: configuration.HTTPClient,
+		client: httpClient,
 
 		baseAddress: address,
 
-		closed: true,
-		pending: make(chan chan struct {
-			sid int
-			err error
-		}, 1),
-		subs: make(map[int]*Subcription),
+		state:     StateClosed,
+		subs:      make(map[*Subscription]struct{}),
+		unsubs:    make(map[int]chan error),
+		published: make(map[Topic]uint64),
+		spooled:   make(chan struct{}, 1),
 	}
+	c.notified = sync.NewCond(&c.notifyMu)
+
+	switch {
+	case configuration.TokenSource != nil:
+		c.tokens = configuration.TokenSource
+	case configuration.ClientCredentials != nil:
+		c.tokens = configuration.ClientCredentials.TokenSource(httpClient)
+	}
+
+	middlewares := append([]Middleware{defaultHeaders(configuration.UserAgent)}, configuration.Middlewares...)
+	c.roundTrip = chain(c.hooks(httpClient.Do), middlewares...)
 
 	c.Trackables = TrackablesAPI{
 		client: &c,
@@ -97,6 +153,14 @@ func newClient(addr string, configuration ClientConfiguration) (*Client, error)
 		client: &c,
 	}
 
+	c.Zones = ZonesAPI{
+		client: &c,
+	}
+
+	c.Fences = FencesAPI{
+		client: &c,
+	}
+
 	return &c, nil
 }
 
@@ -109,10 +173,10 @@ func sendStructuredRequestParseResponse[ResponseT any](
 	body any,
 	parameters url.Values,
 	headers http.Header,
+	opts ...RequestOption,
 ) (*ResponseT, error) {
-	var buf bytes.Buffer
-
-	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+	data, err := marshalJSON(body)
+	if err != nil {
 		return nil, fmt.Errorf("could not encode request body: %w", err)
 	}
 
@@ -121,9 +185,10 @@ func sendStructuredRequestParseResponse[ResponseT any](
 		client,
 		method,
 		path,
-		&buf,
+		data,
 		parameters,
 		headers,
+		opts...,
 	)
 }
 
@@ -133,20 +198,24 @@ func sendRequestParseResponse[ResponseT any](
 	client *Client,
 	method string,
 	path string,
-	body io.Reader,
+	body []byte,
 	parameters url.Values,
 	headers http.Header,
+	opts ...RequestOption,
 ) (*ResponseT, error) {
-	// apply the client-level request timeout, if set
-	if client.configuration.RequestTimeout > 0 {
+	config, err := newRequestConfig(parameters, headers, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the request timeout, if set
+	if timeout := config.requestTimeout(client.configuration.RequestTimeout); timeout > 0 {
 		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, client.configuration.RequestTimeout)
+		ctx, cancel = context.WithTimeout(ctx, timeout)
 		defer cancel()
 	}
 
-	// TODO: set User-Agent and Content-Type headers
-
-	req, err := client.newRequest(ctx, method, path, body, parameters, headers)
+	req, err := client.newRequest(ctx, method, path, body, config.query, config.headers)
 	if err != nil {
 		return nil, err
 	}
@@ -170,20 +239,24 @@ func sendRequestParseResponseList[ResponseT any](
 	client *Client,
 	method string,
 	path string,
-	body io.Reader,
+	body []byte,
 	parameters url.Values,
 	headers http.Header,
+	opts ...RequestOption,
 ) ([]ResponseT, error) {
-	// apply the client-level request timeout, if set
-	if client.configuration.RequestTimeout > 0 {
+	config, err := newRequestConfig(parameters, headers, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the request timeout, if set
+	if timeout := config.requestTimeout(client.configuration.RequestTimeout); timeout > 0 {
 		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, client.configuration.RequestTimeout)
+		ctx, cancel = context.WithTimeout(ctx, timeout)
 		defer cancel()
 	}
 
-	// TODO: set User-Agent and Content-Type headers
-
-	req, err := client.newRequest(ctx, method, path, body, parameters, headers)
+	req, err := client.newRequest(ctx, method, path, body, config.query, config.headers)
 	if err != nil {
 		return nil, err
 	}
@@ -202,11 +275,12 @@ func sendRequestParseResponseList[ResponseT any](
 }
 
 // newRequest constructs a new request.
+// The body is kept in memory, so that the request can be retried.
 func (c *Client) newRequest(
 	ctx context.Context,
 	method string,
 	path string,
-	body io.Reader,
+	body []byte,
 	parameters url.Values,
 	headers http.Header,
 ) (*http.Request, error) {
@@ -218,36 +292,90 @@ func (c *Client) newRequest(
 		url.RawQuery = parameters.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
+	// bytes readers are replayable (see http.Request.GetBody)
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), reader)
 	if err != nil {
 		return nil, fmt.Errorf("could not create '%s %s' request: %w", method, url.String(), err)
 	}
 
 	// populate request headers
 	if headers != nil {
-		req.Header = headers
+		req.Header = headers.Clone()
 	}
 
 	return req, nil
 }
 
-// send sends the given request to Omlox.
+// send sends the given request to Omlox, retrying it if the retry policy is set.
 func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.configuration.Retry
+
+	for retries := 0; ; retries++ {
+		resp, err := c.do(ctx, req)
+
+		if policy == nil || retries >= policy.MaxRetries || !policy.retryable(ctx, req, resp, err) {
+			return resp, err
+		}
+
+		// give up when the hub asks to wait beyond the deadline
+		delay := policy.delay(retries, resp)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+
+		discard(resp)
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+
+		slog.LogAttrs(ctx, slog.LevelDebug, "retrying request",
+			slog.String("method", req.Method),
+			slog.String("url", req.URL.String()),
+			slog.Int("retry", retries+1),
+			slog.Duration("delay", delay),
+		)
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do sends the given request to Omlox once.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	// block on the rate limiter, if set
 	if c.configuration.RateLimiter != nil {
-		c.configuration.RateLimiter.Wait(ctx)
+		if err := c.configuration.RateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
 	}
 
-	return c.client.Do(req)
+	// authorize the request, if set
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		req.Header.Set("Authorization", token.authorization())
+	}
+
+	return c.roundTrip(req)
 }
 
 // parseResponse fully consumes the given response body without closing it and
 // parses the data into a generic Response[T] structure. If the response body
 // is empty, a nil value will be returned.
 func parseResponse[T any](responseBody io.Reader) (*T, error) {
-	// First, read the data into a buffer. This is not super efficient but we
-	// want to know if we actually have a body or not.
-	var buf bytes.Buffer
+	// First, read the data into a pooled buffer, since we want to know
+	// if we actually have a body or not.
+	buf := getBuffer()
+	defer putBuffer(buf)
 
 	_, err := buf.ReadFrom(responseBody)
 	if err != nil {
@@ -259,7 +387,7 @@ func parseResponse[T any](responseBody io.Reader) (*T, error) {
 	}
 
 	var response T
-	if err := json.Unmarshal(buf.Bytes(), &response); err != nil {
+	if err := unmarshalJSON(buf.Bytes(), &response); err != nil {
 		return nil, err
 	}
 
@@ -270,9 +398,10 @@ func parseResponse[T any](responseBody io.Reader) (*T, error) {
 // parses the data into a generic T structure list. If the response body
 // is empty, a empty T list will be returned.
 func parseResponseList[T any](responseBody io.Reader) ([]T, error) {
-	// First, read the data into a buffer. This is not super efficient but we
-	// want to know if we actually have a body or not.
-	var buf bytes.Buffer
+	// First, read the data into a pooled buffer, since we want to know
+	// if we actually have a body or not.
+	buf := getBuffer()
+	defer putBuffer(buf)
 
 	_, err := buf.ReadFrom(responseBody)
 	if err != nil {
@@ -283,10 +412,5 @@ func parseResponseList[T any](responseBody io.Reader) ([]T, error) {
 		return nil, nil
 	}
 
-	var response []T
-	if err := json.Unmarshal(buf.Bytes(), &response); err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return unmarshalJSONList[T](buf.Bytes())
 }
@@ -4,6 +4,7 @@
 package omlox
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -19,8 +20,10 @@ func DefaultConfiguration() ClientConfiguration {
 	defaultClient := cleanhttp.DefaultPooledClient()
 
 	return ClientConfiguration{
-		HTTPClient:     defaultClient,
-		RequestTimeout: 60 * time.Second,
+		HTTPClient:          defaultClient,
+		RequestTimeout:      60 * time.Second,
+		UserAgent:           DefaultUserAgent,
+		SubscriptionTimeout: SubscriptionTimeout,
 	}
 }
 
@@ -44,7 +47,58 @@ type ClientConfiguration struct {
 	RateLimiter *rate.Limiter
 
 	// UserAgent sets a name for the http client User-Agent header.
+	//
+	// Default: omlox-client-go
 	UserAgent string
+
+	// Middlewares wrap the sending of REST requests, the first being the outermost.
+	//
+	// Default: nil
+	Middlewares []Middleware
+
+	// SubscriptionTimeout, given a positive value, limits how long each subscription
+	// waits for the confirmation of the server, unless an earlier deadline is passed
+	// through context.Context.
+	//
+	// Default: 3s
+	SubscriptionTimeout time.Duration
+
+	// Reconnect, if not nil, enables the automatic reconnection of the websocket
+	// client with the given policy.
+	//
+	// Default: nil
+	Reconnect *ReconnectPolicy
+
+	// Spool, if not nil, queues the messages published while the websocket
+	// client is disconnected, to be replayed once it reconnects.
+	//
+	// Default: nil
+	Spool Spool
+
+	// TokenSource, if not nil, supplies the access tokens that authorize the
+	// REST requests (Authorization header) and the websocket subscriptions
+	// and published messages (access_token parameter).
+	//
+	// Default: nil
+	TokenSource TokenSource
+
+	// ClientCredentials, if not nil and no TokenSource is set, obtains the access
+	// tokens with the OAuth2 client credentials flow, using the HTTPClient.
+	//
+	// Default: nil
+	ClientCredentials *ClientCredentials
+
+	// TLSConfig, if not nil, is the TLS configuration used to connect to the hub,
+	// both by REST requests and websockets. It is applied to the transport of the
+	// HTTPClient, which must be an *http.Transport.
+	//
+	// Default: nil
+	TLSConfig *tls.Config
+
+	// Retry, if not nil, retries the REST requests that failed with a transient error.
+	//
+	// Default: nil
+	Retry *RetryPolicy
 }
 
 // ClientOption is a configuration option to initialize a client.
@@ -84,3 +138,121 @@ func WithRateLimiter(limiter *rate.Limiter) ClientOption {
 		return nil
 	}
 }
+
+// WithUserAgent sets the User-Agent header sent to the hub.
+//
+// Default: omlox-client-go
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *ClientConfiguration) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithMiddleware appends middlewares that wrap the sending of REST requests,
+// such as auditing, request IDs or metrics. The middlewares given first are
+// the outermost, and all of them run after the default headers (User-Agent,
+// Content-Type and Accept) and the authorization are set.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *ClientConfiguration) error {
+		c.Middlewares = append(c.Middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithSubscriptionTimeout limits how long each subscription waits for the
+// confirmation of the server. A zero value disables the timeout.
+//
+// Default: 3s
+func WithSubscriptionTimeout(timeout time.Duration) ClientOption {
+	return func(c *ClientConfiguration) error {
+		if timeout < 0 {
+			return fmt.Errorf("subscription timeout must not be negative")
+		}
+		c.SubscriptionTimeout = timeout
+		return nil
+	}
+}
+
+// WithReconnect enables the automatic reconnection of the websocket client.
+// Lost connections are redialed with exponential backoff and the active
+// subscriptions reissued with their original parameters. Subscription
+// channels stay open while the client reconnects.
+//
+// Default: disabled
+func WithReconnect(policy ReconnectPolicy) ClientOption {
+	return func(c *ClientConfiguration) error {
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		c.Reconnect = &policy
+		return nil
+	}
+}
+
+// WithRetryPolicy enables retrying REST requests that failed with a transient
+// error, such as a dropped connection or a 429, 502, 503 or 504 response, with
+// exponential backoff. Only idempotent requests are retried, unless the policy
+// opts in for POST requests. The retries are bound by the request timeout.
+//
+// Default: disabled
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *ClientConfiguration) error {
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		c.Retry = &policy
+		return nil
+	}
+}
+
+// WithSpool queues the messages published while the websocket client is
+// disconnected in the given spool (see [NewMemorySpool] and [OpenDiskSpool]).
+// The messages are replayed in order once the client (re)connects.
+// It is best combined with [WithReconnect].
+//
+// Default: disabled
+func WithSpool(spool Spool) ClientOption {
+	return func(c *ClientConfiguration) error {
+		c.Spool = spool
+		return nil
+	}
+}
+
+// WithTokenSource authorizes the requests to the hub with the access tokens of the
+// given source. Tokens are attached to REST requests as the Authorization header
+// and to websocket subscriptions and published messages as the access_token parameter.
+//
+// Default: nil
+func WithTokenSource(src TokenSource) ClientOption {
+	return func(c *ClientConfiguration) error {
+		c.TokenSource = src
+		return nil
+	}
+}
+
+// WithStaticToken authorizes the requests to the hub with a fixed access token
+// (see [WithTokenSource]).
+func WithStaticToken(accessToken string) ClientOption {
+	return func(c *ClientConfiguration) error {
+		if accessToken == "" {
+			return fmt.Errorf("access token must not be empty")
+		}
+		c.TokenSource = StaticTokenSource(accessToken)
+		return nil
+	}
+}
+
+// WithClientCredentials authorizes the requests to the hub with access tokens obtained
+// through the OAuth2 client credentials flow, which are refreshed before they expire
+// (see [WithTokenSource]). The tokens are requested with the configured HTTP client.
+func WithClientCredentials(cc ClientCredentials) ClientOption {
+	return func(c *ClientConfiguration) error {
+		if cc.TokenURL == "" || cc.ClientID == "" {
+			return fmt.Errorf("client credentials require a token url and a client id")
+		}
+		c.ClientCredentials = &cc
+		c.TokenSource = nil
+		return nil
+	}
+}
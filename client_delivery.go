@@ -0,0 +1,349 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// OverflowStrategy defines what happens to the messages of a subscription
+// when its buffer is full because the consumer is slower than the hub.
+type OverflowStrategy int
+
+// Defines values for OverflowStrategy.
+const (
+	// OverflowDropNewest discards the incoming messages while the buffer is full.
+	OverflowDropNewest OverflowStrategy = iota
+	// OverflowDropOldest discards the oldest buffered message to make room for the incoming one.
+	OverflowDropOldest
+	// OverflowBlock waits for the consumer to make room in the buffer.
+	// It stalls every other subscription on the connection until then.
+	OverflowBlock
+	// OverflowCoalesce keeps only the latest buffered payload of each location provider.
+	// Messages are buffered one payload at a time and payloads without provider_id
+	// are never coalesced. The oldest message is discarded when the buffer is full.
+	// It is only supported by the location_updates and proximity_updates topics.
+	OverflowCoalesce
+)
+
+// FromString assigs itself from type name.
+func (s *OverflowStrategy) FromString(name string) error {
+	v, ok := map[string]OverflowStrategy{
+		OverflowDropNewest.String(): OverflowDropNewest,
+		OverflowDropOldest.String(): OverflowDropOldest,
+		OverflowBlock.String():      OverflowBlock,
+		OverflowCoalesce.String():   OverflowCoalesce,
+	}[name]
+
+	if !ok {
+		return fmt.Errorf("overflow strategy %s not supported", name)
+	}
+
+	*s = v
+	return nil
+}
+
+// String return a text representation.
+func (s OverflowStrategy) String() string {
+	strategies := [...]string{
+		"drop_newest",
+		"drop_oldest",
+		"block",
+		"coalesce",
+	}
+
+	if len(strategies) <= int(s) {
+		return ""
+	}
+
+	return strategies[s]
+}
+
+// SubscribeOption configures a subscription.
+// It is implemented by [Parameter] and [DeliveryOption].
+type SubscribeOption interface {
+	applySubscribe(topic Topic, cfg *subscribeConfig) error
+}
+
+// subscribeConfig is the configuration of a subscription.
+type subscribeConfig struct {
+	params   Parameters
+	delivery delivery
+}
+
+// delivery configures how messages are delivered to a subscription.
+type delivery struct {
+	bufferSize int
+	overflow   OverflowStrategy
+}
+
+// defaultDelivery is the delivery configuration of subscriptions without delivery options.
+var defaultDelivery = delivery{
+	bufferSize: receiveChanSize,
+	overflow:   OverflowDropNewest,
+}
+
+// newSubscribeConfig applies the options to a subscription of the topic.
+func newSubscribeConfig(topic Topic, opts ...SubscribeOption) (subscribeConfig, error) {
+	cfg := subscribeConfig{
+		params:   make(Parameters),
+		delivery: defaultDelivery,
+	}
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt.applySubscribe(topic, &cfg); err != nil {
+			return subscribeConfig{}, err
+		}
+	}
+
+	// payloads are coalesced by location provider, which only these topics have
+	if cfg.delivery.overflow == OverflowCoalesce && topic != TopicLocationUpdates && topic != TopicProximityUpdates {
+		return subscribeConfig{}, fmt.Errorf("overflow strategy %s is not supported by topic '%s'", OverflowCoalesce, topic)
+	}
+
+	return cfg, nil
+}
+
+func (p Parameter) applySubscribe(topic Topic, cfg *subscribeConfig) error {
+	return p(topic, cfg.params)
+}
+
+// DeliveryOption configures how messages are delivered to a subscription.
+type DeliveryOption func(*delivery) error
+
+func (o DeliveryOption) applySubscribe(_ Topic, cfg *subscribeConfig) error {
+	return o(&cfg.delivery)
+}
+
+// WithBufferSize sets how many messages are buffered for a subscription
+// before its overflow strategy is applied.
+//
+// Default: 256
+func WithBufferSize(size int) DeliveryOption {
+	return func(d *delivery) error {
+		if size < 1 {
+			return fmt.Errorf("buffer size must be positive")
+		}
+		d.bufferSize = size
+		return nil
+	}
+}
+
+// WithOverflow sets what happens to the messages of a subscription when its buffer is full.
+//
+// Default: OverflowDropNewest
+func WithOverflow(strategy OverflowStrategy) DeliveryOption {
+	return func(d *delivery) error {
+		if strategy.String() == "" {
+			return fmt.Errorf("unknown overflow strategy %d", strategy)
+		}
+		d.overflow = strategy
+		return nil
+	}
+}
+
+// queued is a buffered message.
+type queued struct {
+	msg *WrapperObject
+
+	// location provider of the message payload, when coalescing.
+	provider string
+}
+
+// msgQueue is a bounded message buffer which applies the overflow strategy when full.
+type msgQueue struct {
+	delivery
+
+	mu    sync.Mutex
+	items []queued
+
+	// set when the subscription ended, so that no message is buffered anymore.
+	closed bool
+
+	// signal that the queue is not empty or not full (buffered).
+	ready chan struct{}
+	room  chan struct{}
+
+	dropped atomic.Uint64
+}
+
+func newMsgQueue(d delivery) *msgQueue {
+	return &msgQueue{
+		delivery: d,
+		items:    make([]queued, 0, d.bufferSize),
+		ready:    make(chan struct{}, 1),
+		room:     make(chan struct{}, 1),
+	}
+}
+
+// push buffers the message, applying the overflow strategy if the buffer is full.
+// It reports if the message was buffered.
+// Only the block strategy waits, until there is room, the context is done or done is closed.
+func (q *msgQueue) push(ctx context.Context, msg *WrapperObject, done <-chan struct{}) bool {
+	if q.overflow == OverflowCoalesce {
+		return q.coalesce(msg)
+	}
+
+	for {
+		q.mu.Lock()
+
+		if q.closed {
+			q.mu.Unlock()
+			return false
+		}
+
+		if len(q.items) < q.bufferSize {
+			q.items = append(q.items, queued{msg: msg})
+			q.mu.Unlock()
+			signal(q.ready)
+			return true
+		}
+
+		switch q.overflow {
+		case OverflowDropOldest:
+			q.items = append(q.items[1:], queued{msg: msg})
+			q.mu.Unlock()
+			q.dropped.Add(1)
+			return true
+		case OverflowBlock:
+			q.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+			case <-done:
+			case <-q.room:
+				continue
+			}
+		default:
+			q.mu.Unlock()
+		}
+
+		q.dropped.Add(1)
+		return false
+	}
+}
+
+// coalesce buffers each payload of the message on its own, replacing
+// the buffered payload of the same location provider, if any.
+func (q *msgQueue) coalesce(msg *WrapperObject) bool {
+	q.mu.Lock()
+	defer signal(q.ready)
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	for _, payload := range msg.Payload {
+		single := *msg
+		single.Payload = []json.RawMessage{payload}
+
+		item := queued{msg: &single, provider: payloadProvider(payload)}
+
+		if i := q.indexOf(item.provider); i >= 0 {
+			q.items[i] = item
+			q.dropped.Add(1)
+			continue
+		}
+
+		if len(q.items) >= q.bufferSize {
+			q.items = q.items[1:]
+			q.dropped.Add(1)
+		}
+		q.items = append(q.items, item)
+	}
+
+	return true
+}
+
+// close discards the buffered messages, counting them as dropped, and rejects the later ones.
+func (q *msgQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.dropped.Add(uint64(len(q.items)))
+
+	clear(q.items)
+	q.items = q.items[:0]
+}
+
+// indexOf returns the index of the buffered message of the location provider, or -1.
+func (q *msgQueue) indexOf(provider string) int {
+	if provider == "" {
+		return -1
+	}
+
+	for i, item := range q.items {
+		if item.provider == provider {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// pop removes the oldest buffered message, waiting for one until done is closed.
+func (q *msgQueue) pop(done <-chan struct{}) (*WrapperObject, bool) {
+	for {
+		q.mu.Lock()
+		if len(q.items) != 0 {
+			msg := q.items[0].msg
+			q.items[0] = queued{}
+			q.items = q.items[1:]
+			q.mu.Unlock()
+
+			signal(q.room)
+			return msg, true
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-done:
+			return nil, false
+		case <-q.ready:
+		}
+	}
+}
+
+// payloadProvider returns the provider_id of the payload, if any.
+// Only the top-level fields are scanned, the others are skipped without being decoded.
+func payloadProvider(payload json.RawMessage) string {
+	in := jlexer.Lexer{Data: payload}
+
+	in.Delim('{')
+	for !in.IsDelim('}') && in.Ok() {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+
+		if key == "provider_id" {
+			if id := in.String(); in.Ok() {
+				return id
+			}
+			return ""
+		}
+
+		in.SkipRecursive()
+		in.WantComma()
+	}
+
+	return ""
+}
+
+// signal notifies a buffered signal channel without blocking.
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
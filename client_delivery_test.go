@@ -0,0 +1,201 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testMsg returns a message with a payload for each of the location providers.
+func testMsg(providers ...string) *WrapperObject {
+	msg := &WrapperObject{Event: EventMsg, Topic: TopicLocationUpdates}
+	for _, p := range providers {
+		msg.Payload = append(msg.Payload, json.RawMessage(fmt.Sprintf(`{"provider_id":%q}`, p)))
+	}
+	return msg
+}
+
+// drainProviders pops every buffered message, returning the providers of their payloads.
+func drainProviders(q *msgQueue) []string {
+	var providers []string
+
+	done := make(chan struct{})
+	close(done)
+
+	for {
+		q.mu.Lock()
+		n := len(q.items)
+		q.mu.Unlock()
+		if n == 0 {
+			return providers
+		}
+
+		msg, _ := q.pop(done)
+		for _, p := range msg.Payload {
+			providers = append(providers, payloadProvider(p))
+		}
+	}
+}
+
+func TestMsgQueueOverflow(t *testing.T) {
+	cases := []struct {
+		strategy OverflowStrategy
+		msgs     []*WrapperObject
+		want     []string
+		dropped  uint64
+	}{
+		{OverflowDropNewest, []*WrapperObject{testMsg("a"), testMsg("b"), testMsg("c")}, []string{"a", "b"}, 1},
+		{OverflowDropOldest, []*WrapperObject{testMsg("a"), testMsg("b"), testMsg("c")}, []string{"b", "c"}, 1},
+		{OverflowCoalesce, []*WrapperObject{testMsg("a", "b"), testMsg("a")}, []string{"a", "b"}, 1},
+		{OverflowCoalesce, []*WrapperObject{testMsg("a"), testMsg("b"), testMsg("c")}, []string{"b", "c"}, 1},
+		{OverflowCoalesce, []*WrapperObject{testMsg("", ""), testMsg("")}, []string{"", ""}, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.strategy.String(), func(t *testing.T) {
+			q := newMsgQueue(delivery{bufferSize: 2, overflow: tc.strategy})
+
+			for _, msg := range tc.msgs {
+				q.push(context.Background(), msg, nil)
+			}
+
+			if diff := cmp.Diff(tc.want, drainProviders(q)); diff != "" {
+				t.Errorf("buffered messages mismatch (-want +got):\n%s", diff)
+			}
+
+			if n := q.dropped.Load(); n != tc.dropped {
+				t.Errorf("dropped = %d, want %d", n, tc.dropped)
+			}
+		})
+	}
+}
+
+func TestMsgQueueBlock(t *testing.T) {
+	q := newMsgQueue(delivery{bufferSize: 1, overflow: OverflowBlock})
+
+	if !q.push(context.Background(), testMsg("a"), nil) {
+		t.Fatal("message not buffered")
+	}
+
+	// gives up when the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if q.push(ctx, testMsg("b"), nil) {
+		t.Fatal("message buffered in full queue")
+	}
+
+	// waits until there is room
+	res := make(chan bool, 1)
+	go func() {
+		res <- q.push(context.Background(), testMsg("c"), nil)
+	}()
+
+	if msg, _ := q.pop(nil); payloadProvider(msg.Payload[0]) != "a" {
+		t.Errorf("popped %v, want provider a", msg)
+	}
+
+	select {
+	case ok := <-res:
+		if !ok {
+			t.Error("message not buffered after room was made")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for blocked push")
+	}
+
+	if diff := cmp.Diff([]string{"c"}, drainProviders(q)); diff != "" {
+		t.Errorf("buffered messages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPayloadProvider(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{"location", `{"source":"s","provider_type":"uwb","provider_id":"77:4f:34:69:27:40","crs":"local"}`, "77:4f:34:69:27:40"},
+		{"nested-first", `{"position":{"type":"Point","coordinates":[1,2]},"properties":{"provider_id":"nested"},"provider_id":"top"}`, "top"},
+		{"escaped", `{"provider_id":"a\"b"}`, `a"b`},
+		{"missing", `{"source":"s"}`, ""},
+		{"not-a-string", `{"provider_id":7}`, ""},
+		{"not-an-object", `[{"provider_id":"a"}]`, ""},
+		{"invalid", `{"provider_id"`, ""},
+		{"null", `null`, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := payloadProvider(json.RawMessage(tc.payload)); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSubscribeOptions(t *testing.T) {
+	cfg, err := newSubscribeConfig(TopicLocationUpdates, WithCRS("local"), WithBufferSize(8), WithOverflow(OverflowCoalesce))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(Parameters{ParamCRS: "local"}, cfg.params); diff != "" {
+		t.Errorf("params mismatch (-want +got):\n%s", diff)
+	}
+
+	if want := (delivery{bufferSize: 8, overflow: OverflowCoalesce}); cfg.delivery != want {
+		t.Errorf("delivery = %+v, want %+v", cfg.delivery, want)
+	}
+
+	invalid := []SubscribeOption{
+		WithBufferSize(0),
+		WithOverflow(OverflowStrategy(99)),
+		WithFenceID([16]byte{}),
+	}
+	for _, opt := range invalid {
+		if _, err := newSubscribeConfig(TopicLocationUpdates, opt); err == nil {
+			t.Errorf("expected error for option %T", opt)
+		}
+	}
+
+	// payloads are only coalesced by location provider
+	for _, topic := range []Topic{TopicLocationUpdates, TopicProximityUpdates} {
+		if _, err := newSubscribeConfig(topic, WithOverflow(OverflowCoalesce)); err != nil {
+			t.Errorf("coalescing %s: %v", topic, err)
+		}
+	}
+	for _, topic := range []Topic{TopicFenceEvents, TopicCollisionEvents, TopicTrackableMotions} {
+		if _, err := newSubscribeConfig(topic, WithOverflow(OverflowCoalesce)); err == nil {
+			t.Errorf("expected error coalescing %s", topic)
+		}
+	}
+}
+
+func TestSlowSubscription(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	slow := subscribeOK(t, c, hc, TopicLocationUpdates, 1, WithBufferSize(1))
+	fast := subscribeOK(t, c, hc, TopicFenceEvents, 2)
+
+	// the slow subscription is never received from
+	for i := 0; i < 3; i++ {
+		hc.send(t, `{"event":"message","topic":"location_updates","subscription_id":1,"payload":[{"n":1}]}`)
+	}
+
+	hc.send(t, `{"event":"message","topic":"fence_events","subscription_id":2,"payload":[{"n":2}]}`)
+	receiveOK(t, fast)
+
+	// at most one message is buffered and another pending reception
+	if n := slow.Dropped(); n == 0 {
+		t.Error("slow subscription dropped no messages")
+	}
+}
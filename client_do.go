@@ -0,0 +1,82 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Do sends a request to an endpoint of the hub, such as a vendor extension, and decodes
+// the JSON response into T. The path is relative to the client address. A nil value is
+// returned if the response has no body.
+//
+// The body is sent as is if it is a []byte, [json.RawMessage] or io.Reader, otherwise
+// it is encoded as JSON. A nil body sends no body.
+//
+// The request goes through the same timeout, rate limiter, authorization, retries and
+// error parsing as the API methods.
+func Do[T any](ctx context.Context, client *Client, method string, path string, body any, opts ...RequestOption) (*T, error) {
+	data, err := requestBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendRequestParseResponse[T](
+		ctx,
+		client,
+		method,
+		path,
+		data,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// DoList is like [Do], but decodes a JSON array response into a list of T.
+func DoList[T any](ctx context.Context, client *Client, method string, path string, body any, opts ...RequestOption) ([]T, error) {
+	data, err := requestBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendRequestParseResponseList[T](
+		ctx,
+		client,
+		method,
+		path,
+		data,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// requestBody returns the raw request body, which is kept in memory so that the request can be retried.
+func requestBody(body any) ([]byte, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return b, nil
+	case json.RawMessage:
+		return b, nil
+	case io.Reader:
+		data, err := io.ReadAll(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not read request body: %w", err)
+		}
+		return data, nil
+	}
+
+	data, err := marshalJSON(body)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode request body: %w", err)
+	}
+
+	return data, nil
+}
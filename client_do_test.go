@@ -0,0 +1,115 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// vendorItem is a resource of a vendor endpoint.
+type vendorItem struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func newVendorHub(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/vendor/items":
+			fmt.Fprintf(w, `[{"name":"a","value":1},{"name":%q,"value":2}]`, r.URL.Query().Get("name"))
+		case r.Method == http.MethodPost && r.URL.Path == "/vendor/items":
+			// echoes the created item
+			io.Copy(w, r.Body)
+		case r.Method == http.MethodDelete && r.URL.Path == "/vendor/items/a":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type":"not found","code":404,"message":"unknown endpoint"}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	srv := newVendorHub(t)
+
+	c, err := New(srv.URL, WithStaticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		items, err := DoList[vendorItem](ctx, c, http.MethodGet, "/vendor/items", nil, WithQuery("name", "b"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []vendorItem{{"a", 1}, {"b", 2}}
+		if diff := cmp.Diff(want, items); diff != "" {
+			t.Errorf("DoList() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("structured_body", func(t *testing.T) {
+		item, err := Do[vendorItem](ctx, c, http.MethodPost, "/vendor/items", vendorItem{"c", 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *item != (vendorItem{"c", 3}) {
+			t.Errorf("got %v, want %v", *item, vendorItem{"c", 3})
+		}
+	})
+
+	t.Run("raw_body", func(t *testing.T) {
+		for _, body := range []any{[]byte(`{"name":"d","value":4}`), strings.NewReader(`{"name":"d","value":4}`)} {
+			item, err := Do[vendorItem](ctx, c, http.MethodPost, "/vendor/items", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if *item != (vendorItem{"d", 4}) {
+				t.Errorf("got %v, want %v", *item, vendorItem{"d", 4})
+			}
+		}
+	})
+
+	t.Run("no_content", func(t *testing.T) {
+		item, err := Do[struct{}](ctx, c, http.MethodDelete, "/vendor/items/a", nil)
+		if err != nil || item != nil {
+			t.Fatalf("got %v, %v, want no content", item, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		if _, err := Do[vendorItem](ctx, c, http.MethodGet, "/vendor/unknown", nil); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("got error %v, want %v", err, ErrNotFound)
+		}
+	})
+
+	t.Run("unsupported_body", func(t *testing.T) {
+		if _, err := Do[vendorItem](ctx, c, http.MethodPost, "/vendor/items", make(chan int)); err == nil {
+			t.Fatal("expected encoding error")
+		}
+	})
+}
@@ -0,0 +1,249 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"log/slog"
+)
+
+// HubError is a websocket error sent by the Omlox™ Hub, correlated
+// to the request of the client that caused it when possible.
+// It is also used to report the spooled messages that could not be replayed (see [HubError.Err]).
+type HubError struct {
+	WebsocketError
+
+	// Err is the error of a spooled message that could not be replayed
+	// (e.g. the access token was unavailable), in which case the websocket error is empty.
+	Err error
+
+	// Topic and subscription ID sent along with the error, if any.
+	Topic          Topic
+	SubscriptionID int
+
+	// Subscription affected by the error, if any.
+	Subscription *Subscription
+
+	// Request is the subscribe or unsubscribe request of the client
+	// to which the error was correlated, or the spooled message that could not be replayed, if any.
+	Request *WrapperObject
+
+	// Published identifies the published message to which the error was correlated, if any.
+	// The correlation is best-effort, as the hub doesn't identify the message that caused the error:
+	// it is the last message published to the topic of the error (or the last one, without topic)
+	// before the error was received.
+	Published *Publication
+}
+
+// Publication identifies a message published by the client.
+type Publication struct {
+	// Topic of the published message.
+	Topic Topic
+
+	// Seq is the sequence number of the message among all the messages
+	// published by the client, starting at 1.
+	Seq uint64
+}
+
+var (
+	_ error          = (*HubError)(nil)
+	_ slog.LogValuer = (*HubError)(nil)
+)
+
+// Error implements the error interface.
+func (err *HubError) Error() string {
+	if err.Err != nil {
+		return "spooled message not replayed: " + err.Err.Error()
+	}
+	return err.WebsocketError.Error()
+}
+
+// Unwrap returns the underlying websocket error, or the replay error of a spooled message.
+func (err *HubError) Unwrap() error {
+	if err.Err != nil {
+		return err.Err
+	}
+	return err.WebsocketError
+}
+
+// LogValue implements slog.LogValuer.
+func (err *HubError) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.Int("code", int(err.Code)),
+		slog.String("description", err.Description),
+		slog.String("topic", string(err.Topic)),
+		slog.Int("sid", err.SubscriptionID),
+	}
+
+	if err.Err != nil {
+		attrs = append(attrs, slog.Any("err", err.Err))
+	}
+
+	if err.Request != nil {
+		attrs = append(attrs, slog.Any("request", err.Request))
+	}
+
+	if err.Published != nil {
+		attrs = append(attrs, slog.Group("published",
+			slog.String("topic", string(err.Published.Topic)),
+			slog.Uint64("seq", err.Published.Seq),
+		))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// OnError registers a function to be called with every websocket error sent by the hub,
+// including those already returned to the caller of the request that caused them
+// (e.g. [Client.Subscribe]) and those reported asynchronously (e.g. for published messages),
+// as well as with the spooled messages that could not be replayed (see [HubError.Err]).
+// The function is called synchronously by the client read loop, so it must not block.
+func (c *Client) OnError(fn func(err *HubError)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errorHandlers = append(c.errorHandlers, fn)
+}
+
+// handleError handles any websocket error sent by the server,
+// failing the request that caused it and notifying the error handlers.
+func (c *Client) handleError(msg *wrapperObject) {
+	err := &HubError{
+		WebsocketError: msg.WebsocketError,
+		Topic:          msg.Topic,
+		SubscriptionID: msg.SubscriptionID,
+	}
+
+	switch err.Code {
+	case ErrCodeUnsubscription:
+		err.Subscription = c.subByID(msg.SubscriptionID)
+		if err.Subscription != nil {
+			err.Request = &WrapperObject{
+				Event:          EventUnsubscribe,
+				SubscriptionID: msg.SubscriptionID,
+			}
+		}
+		c.resolveUnsub(msg.SubscriptionID, err)
+
+	case ErrCodeSubscription, ErrCodeUnknownTopic, ErrCodeNotAuthorized, ErrCodeInvalid:
+		// fail the pending subscription, if any
+		if pending := c.rejectSub(msg.Topic, err); pending != nil {
+			break
+		}
+
+		// otherwise, the error was caused by a published message
+		err.Subscription = c.subByID(msg.SubscriptionID)
+		err.Published = c.lastPublished(msg.Topic)
+
+	default:
+		err.Subscription = c.subByID(msg.SubscriptionID)
+		err.Published = c.lastPublished(msg.Topic)
+	}
+
+	slog.LogAttrs(context.Background(), slog.LevelDebug, "hub error", slog.Any("err", err))
+
+	c.notifyError(err)
+}
+
+// notifyError calls the error handlers with the error.
+func (c *Client) notifyError(err *HubError) {
+	c.mu.RLock()
+	handlers := c.errorHandlers
+	c.mu.RUnlock()
+
+	for _, fn := range handlers {
+		fn(err)
+	}
+}
+
+// subByID returns the subscription with the given ID, if any.
+func (c *Client) subByID(sid int) *Subscription {
+	if sid == 0 {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for sub := range c.subs {
+		if sub.sid == sid {
+			return sub
+		}
+	}
+
+	return nil
+}
+
+// publishMark is the state replaced by a tracked publication, to undo it.
+type publishMark struct {
+	topic     Topic
+	seq       uint64
+	prev      uint64
+	prevTopic Topic
+}
+
+// trackPublished numbers the message about to be published to the topic, keeping the last sequence
+// number of each topic so that asynchronous errors of the hub can be correlated to them.
+func (c *Client) trackPublished(topic Topic) publishMark {
+	c.pubMu.Lock()
+	defer c.pubMu.Unlock()
+
+	c.pubSeq++
+	mark := publishMark{
+		topic:     topic,
+		seq:       c.pubSeq,
+		prev:      c.published[topic],
+		prevTopic: c.lastTopic,
+	}
+
+	c.published[topic] = c.pubSeq
+	c.lastTopic = topic
+
+	return mark
+}
+
+// untrackPublished undoes the tracking of a message that could not be written,
+// unless other messages were published in the meantime.
+func (c *Client) untrackPublished(mark publishMark) {
+	c.pubMu.Lock()
+	defer c.pubMu.Unlock()
+
+	if c.published[mark.topic] == mark.seq {
+		if mark.prev == 0 {
+			delete(c.published, mark.topic)
+		} else {
+			c.published[mark.topic] = mark.prev
+		}
+	}
+
+	if c.pubSeq == mark.seq {
+		c.pubSeq--
+		c.lastTopic = mark.prevTopic
+	}
+}
+
+// publishedSeq returns the sequence number of the last published message.
+func (c *Client) publishedSeq() uint64 {
+	c.pubMu.Lock()
+	defer c.pubMu.Unlock()
+
+	return c.pubSeq
+}
+
+// lastPublished returns the last message published to the topic,
+// or the last published message if the topic is empty.
+func (c *Client) lastPublished(topic Topic) *Publication {
+	c.pubMu.Lock()
+	defer c.pubMu.Unlock()
+
+	if topic == "" {
+		topic = c.lastTopic
+	}
+
+	seq, ok := c.published[topic]
+	if !ok {
+		return nil
+	}
+
+	return &Publication{Topic: topic, Seq: seq}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// collectErrors registers an error handler and returns the channel of reported errors.
+func collectErrors(c *Client) <-chan *HubError {
+	errs := make(chan *HubError, 16)
+	c.OnError(func(err *HubError) {
+		errs <- err
+	})
+	return errs
+}
+
+// expectHubError waits for the next error reported by the client and checks its code.
+func expectHubError(t *testing.T, errs <-chan *HubError, code ErrCode) *HubError {
+	t.Helper()
+
+	select {
+	case err := <-errs:
+		if err.Code != code {
+			t.Fatalf("got error code %d, want %d", err.Code, code)
+		}
+		return err
+	case <-time.After(testTimeout):
+		t.Fatalf("timeout waiting for error %d", code)
+		return nil
+	}
+}
+
+func TestHubErrorSubscription(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+	errs := collectErrors(c)
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicLocationUpdates, WithCRS("local"))
+		res <- err
+	}()
+
+	hc.expect(t, EventSubscribe)
+	hc.send(t, `{"event":"error","code":10004,"description":"not authorized","topic":"location_updates"}`)
+
+	hubErr := expectHubError(t, errs, ErrCodeNotAuthorized)
+	if hubErr.Request == nil || hubErr.Request.Event != EventSubscribe || hubErr.Request.Params[ParamCRS] != "local" {
+		t.Errorf("error correlated to %v, want the subscription request", hubErr.Request)
+	}
+
+	var err *HubError
+	if !errors.As(<-res, &err) || err != hubErr {
+		t.Errorf("subscribe returned %v, want %v", err, hubErr)
+	}
+}
+
+func TestHubErrorPublish(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+	errs := collectErrors(c)
+
+	// a pending subscription to another topic must not be failed by the publish error
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicFenceEvents)
+		res <- err
+	}()
+	hc.expect(t, EventSubscribe)
+
+	payload := json.RawMessage(`{"invalid":true}`)
+	if err := c.Publish(context.Background(), TopicProximityUpdates, payload); err != nil {
+		t.Fatal(err)
+	}
+	hc.expect(t, EventMsg)
+
+	hc.send(t, `{"event":"error","code":10005,"description":"invalid payload","topic":"proximity_updates"}`)
+
+	hubErr := expectHubError(t, errs, ErrCodeInvalid)
+	if want := (Publication{Topic: TopicProximityUpdates, Seq: 1}); hubErr.Published == nil || *hubErr.Published != want {
+		t.Errorf("error correlated to %v, want %v", hubErr.Published, want)
+	}
+	if hubErr.Request != nil {
+		t.Errorf("error correlated to request %v, want none", hubErr.Request)
+	}
+
+	select {
+	case err := <-res:
+		t.Fatalf("pending subscription ended with %v", err)
+	default:
+	}
+
+	hc.send(t, `{"event":"subscribed","topic":"fence_events","subscription_id":1}`)
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+
+	// errors without topic are correlated to the last published message
+	if err := c.Publish(context.Background(), TopicLocationUpdates, payload); err != nil {
+		t.Fatal(err)
+	}
+	hc.expect(t, EventMsg)
+
+	hc.send(t, `{"event":"error","code":10005,"description":"invalid payload"}`)
+
+	hubErr = expectHubError(t, errs, ErrCodeInvalid)
+	if want := (Publication{Topic: TopicLocationUpdates, Seq: 2}); hubErr.Published == nil || *hubErr.Published != want {
+		t.Errorf("error correlated to %v, want %v", hubErr.Published, want)
+	}
+}
+
+func TestHubErrorUncorrelated(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+	errs := collectErrors(c)
+
+	sub := subscribeOK(t, c, hc, TopicLocationUpdates, 4)
+
+	hc.send(t, `{"event":"error","code":10000,"description":"unknown event","subscription_id":4}`)
+
+	hubErr := expectHubError(t, errs, ErrCodeUnknown)
+	if hubErr.Subscription != sub {
+		t.Errorf("error correlated to subscription %v, want %v", hubErr.Subscription, sub)
+	}
+	if hubErr.Request != nil || hubErr.Published != nil {
+		t.Errorf("error correlated to request %v and publication %v, want none", hubErr.Request, hubErr.Published)
+	}
+
+	// nothing pending to fail
+	hc.send(t, `{"event":"error","code":10002,"description":"subscription failed"}`)
+	expectHubError(t, errs, ErrCodeSubscription)
+
+	if sub.Err() != nil {
+		t.Errorf("subscription ended with %v", sub.Err())
+	}
+}
+
+func TestHubErrorWithoutTopic(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+	errs := collectErrors(c)
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicFenceEvents)
+		res <- err
+	}()
+	hc.expect(t, EventSubscribe)
+
+	// published after the subscription request, so the error is attributed to it
+	if err := c.Publish(context.Background(), TopicProximityUpdates, json.RawMessage(`{"invalid":true}`)); err != nil {
+		t.Fatal(err)
+	}
+	hc.expect(t, EventMsg)
+
+	hc.send(t, `{"event":"error","code":10005,"description":"invalid payload"}`)
+
+	hubErr := expectHubError(t, errs, ErrCodeInvalid)
+	if want := (Publication{Topic: TopicProximityUpdates, Seq: 1}); hubErr.Published == nil || *hubErr.Published != want {
+		t.Errorf("error correlated to %v, want %v", hubErr.Published, want)
+	}
+	if hubErr.Request != nil {
+		t.Errorf("error correlated to request %v, want none", hubErr.Request)
+	}
+
+	select {
+	case err := <-res:
+		t.Fatalf("pending subscription ended with %v", err)
+	default:
+	}
+
+	hc.send(t, `{"event":"subscribed","topic":"fence_events","subscription_id":1}`)
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing published since the subscription request, so the error is attributed to it
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicLocationUpdates)
+		res <- err
+	}()
+	hc.expect(t, EventSubscribe)
+
+	hc.send(t, `{"event":"error","code":10004,"description":"not authorized"}`)
+
+	hubErr = expectHubError(t, errs, ErrCodeNotAuthorized)
+	if hubErr.Request == nil || hubErr.Request.Topic != TopicLocationUpdates || hubErr.Published != nil {
+		t.Errorf("error correlated to request %v and publication %v, want the subscription request", hubErr.Request, hubErr.Published)
+	}
+
+	var err *HubError
+	if !errors.As(<-res, &err) || err != hubErr {
+		t.Errorf("subscribe returned %v, want %v", err, hubErr)
+	}
+}
+
+func TestTrackPublished(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	if err := c.Publish(context.Background(), TopicProximityUpdates, json.RawMessage(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	hc.expect(t, EventMsg)
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// messages that could not be written are not correlated to errors
+	if err := c.Publish(context.Background(), TopicLocationUpdates, json.RawMessage(`{}`)); err == nil {
+		t.Fatal("published on a closed client")
+	}
+
+	want := Publication{Topic: TopicProximityUpdates, Seq: 1}
+	if got := c.lastPublished(""); got == nil || *got != want {
+		t.Errorf("last published %v, want %v", got, want)
+	}
+	if got := c.lastPublished(TopicLocationUpdates); got != nil {
+		t.Errorf("last published to %s %v, want none", TopicLocationUpdates, got)
+	}
+}
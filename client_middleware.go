@@ -0,0 +1,87 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"net/http"
+)
+
+// DefaultUserAgent is the User-Agent header sent by the client, unless configured (see [WithUserAgent]).
+const DefaultUserAgent = "omlox-client-go"
+
+// RoundTripFunc sends a REST request to the hub and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of REST requests, to inspect or modify the requests
+// and their responses (e.g. auditing, request IDs or metrics).
+// Middlewares are called for every attempt of a request (see [WithRetryPolicy]).
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// OnRequest registers a function to be called with every REST request before it is sent,
+// after all middlewares were applied. The request must not be modified.
+func (c *Client) OnRequest(fn func(req *http.Request)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requestHandlers = append(c.requestHandlers, fn)
+}
+
+// OnResponse registers a function to be called with the response, or the error,
+// of every REST request sent. The response body must not be consumed.
+func (c *Client) OnResponse(fn func(req *http.Request, resp *http.Response, err error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responseHandlers = append(c.responseHandlers, fn)
+}
+
+// chain returns the round trip of the given transport wrapped by the middlewares,
+// the first middleware being the outermost.
+func chain(transport RoundTripFunc, middlewares ...Middleware) RoundTripFunc {
+	rt := transport
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] != nil {
+			rt = middlewares[i](rt)
+		}
+	}
+	return rt
+}
+
+// defaultHeaders sets the User-Agent, Content-Type and Accept headers, unless already set.
+func defaultHeaders(userAgent string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("User-Agent") == "" && userAgent != "" {
+				req.Header.Set("User-Agent", userAgent)
+			}
+			if req.Header.Get("Content-Type") == "" && req.Body != nil && req.Body != http.NoBody {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			if req.Header.Get("Accept") == "" {
+				req.Header.Set("Accept", "application/json")
+			}
+			return next(req)
+		}
+	}
+}
+
+// hooks calls the request and response handlers of the client around the transport.
+func (c *Client) hooks(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		c.mu.RLock()
+		onRequest := c.requestHandlers
+		onResponse := c.responseHandlers
+		c.mu.RUnlock()
+
+		for _, fn := range onRequest {
+			fn(req)
+		}
+
+		resp, err := next(req)
+
+		for _, fn := range onResponse {
+			fn(req, resp, err)
+		}
+
+		return resp, err
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestDefaultHeaders(t *testing.T) {
+	reqs := make(chan http.Header, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs <- r.Header
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name        string
+		options     []ClientOption
+		send        func(c *Client) error
+		userAgent   string
+		contentType string
+	}{
+		{
+			name: "get",
+			send: func(c *Client) error {
+				_, err := c.Zones.Get(context.Background(), uuid.Nil)
+				return err
+			},
+			userAgent: DefaultUserAgent,
+		},
+		{
+			name:    "post",
+			options: []ClientOption{WithUserAgent("reconciler/1.0")},
+			send: func(c *Client) error {
+				_, err := c.Zones.Create(context.Background(), Zone{Name: "zone"})
+				return err
+			},
+			userAgent:   "reconciler/1.0",
+			contentType: "application/json",
+		},
+		{
+			name: "override",
+			send: func(c *Client) error {
+				_, err := Do[struct{}](context.Background(), c, http.MethodPost, "/vendor", []byte("<xml/>"), WithHeader("Content-Type", "application/xml"))
+				return err
+			},
+			userAgent:   DefaultUserAgent,
+			contentType: "application/xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(srv.URL, tt.options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := tt.send(c); err != nil {
+				t.Fatal(err)
+			}
+
+			h := <-reqs
+			if got := h.Get("User-Agent"); got != tt.userAgent {
+				t.Errorf("User-Agent = %q, want %q", got, tt.userAgent)
+			}
+			if got := h.Get("Content-Type"); got != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.contentType)
+			}
+			if got := h.Get("Accept"); got != "application/json" {
+				t.Errorf("Accept = %q, want %q", got, "application/json")
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", r.Header.Get("X-Request-ID"))
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+	record := func(format string, args ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, fmt.Sprintf(format, args...))
+	}
+
+	middleware := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				record("%s request", name)
+				req.Header.Set("X-Request-ID", "42")
+				resp, err := next(req)
+				record("%s response", name)
+				return resp, err
+			}
+		}
+	}
+
+	c, err := New(srv.URL, WithMiddleware(middleware("outer"), middleware("inner")), WithStaticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.OnRequest(func(req *http.Request) {
+		record("hook request %s %s", req.Header.Get("X-Request-ID"), req.Header.Get("Authorization"))
+	})
+	c.OnResponse(func(req *http.Request, resp *http.Response, err error) {
+		record("hook response %d %s", resp.StatusCode, resp.Header.Get("X-Request-ID"))
+	})
+
+	if _, err := c.Zones.List(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"outer request",
+		"inner request",
+		"hook request 42 Bearer token",
+		"hook response 200 42",
+		"inner response",
+		"outer response",
+	}
+	if diff := cmp.Diff(want, events); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Errors
+var (
+	ErrPublisherClosed = errors.New("publisher closed")
+)
+
+// PublisherOption is a configuration option of a [LocationPublisher].
+type PublisherOption func(*publisherConfig) error
+
+// publisherConfig is the configuration of a location publisher.
+type publisherConfig struct {
+	size     int
+	interval time.Duration
+	onError  func(batch []Location, err error)
+}
+
+// WithBatchSize sets the maximum number of locations published in each wrapper object.
+// A batch is published as soon as it is full.
+//
+// Default: 100
+func WithBatchSize(size int) PublisherOption {
+	return func(c *publisherConfig) error {
+		if size < 1 {
+			return fmt.Errorf("batch size must be positive")
+		}
+		c.size = size
+		return nil
+	}
+}
+
+// WithBatchInterval sets how long locations are buffered before being published,
+// if the batch doesn't fill up first.
+//
+// Default: 100ms
+func WithBatchInterval(interval time.Duration) PublisherOption {
+	return func(c *publisherConfig) error {
+		if interval <= 0 {
+			return fmt.Errorf("batch interval must be positive")
+		}
+		c.interval = interval
+		return nil
+	}
+}
+
+// WithBatchErrorHandler sets the function called with each batch that could not be published.
+// The function is called synchronously by the publisher, so it should not block.
+//
+// Default: batch errors are logged
+func WithBatchErrorHandler(fn func(batch []Location, err error)) PublisherOption {
+	return func(c *publisherConfig) error {
+		if fn == nil {
+			return fmt.Errorf("batch error handler must not be nil")
+		}
+		c.onError = fn
+		return nil
+	}
+}
+
+// LocationPublisher publishes location updates to the Omlox Hub in batches.
+// Locations are buffered and published in a single wrapper object when the batch
+// is full or its interval elapses, honouring the rate limiter of the client.
+// It is safe for concurrent use.
+type LocationPublisher struct {
+	client *Client
+	config publisherConfig
+
+	mu     sync.Mutex
+	batch  locationBatch
+	closed bool
+
+	// full batches handed to the publishing goroutine.
+	full chan locationBatch
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// locationBatch is a batch of locations along with their encoded payloads.
+type locationBatch struct {
+	locations []Location
+	payload   []json.RawMessage
+}
+
+// NewLocationPublisher returns a publisher of location updates.
+// The context bounds the lifetime of the publisher, which must be closed to publish the buffered locations.
+// If the context is cancelled first, the buffered locations are reported to the batch error handler.
+func (c *Client) NewLocationPublisher(ctx context.Context, options ...PublisherOption) (*LocationPublisher, error) {
+	config := publisherConfig{
+		size:     100,
+		interval: 100 * time.Millisecond,
+		onError: func(batch []Location, err error) {
+			slog.LogAttrs(context.Background(), slog.LevelWarn, "location batch not published", slog.Int("size", len(batch)), slog.Any("err", err))
+		},
+	}
+
+	for _, opt := range options {
+		if opt != nil {
+			if err := opt(&config); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	p := &LocationPublisher{
+		client: c,
+		config: config,
+		full:   make(chan locationBatch),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	p.batch = p.newBatch()
+
+	go p.run(ctx)
+
+	return p, nil
+}
+
+// Publish validates and buffers the locations to be published (see [Location.Validate]).
+// It waits if a full batch is still being published, unless the context is done first.
+// Publishing errors are reported to the batch error handler (see [WithBatchErrorHandler]).
+func (p *LocationPublisher) Publish(ctx context.Context, locations ...Location) error {
+	payload, err := p.client.marshalLocations(locations)
+	if err != nil {
+		return err
+	}
+
+	for i := range locations {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return ErrPublisherClosed
+		}
+
+		p.batch.locations = append(p.batch.locations, locations[i])
+		p.batch.payload = append(p.batch.payload, payload[i])
+
+		if len(p.batch.payload) < p.config.size {
+			p.mu.Unlock()
+			continue
+		}
+
+		batch := p.batch
+		p.batch = p.newBatch()
+		p.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			p.config.onError(batch.locations, ctx.Err())
+			return ctx.Err()
+		case <-p.done:
+			p.config.onError(batch.locations, ErrPublisherClosed)
+			return ErrPublisherClosed
+		case p.full <- batch:
+		}
+	}
+
+	return nil
+}
+
+// Close publishes the buffered locations and stops the publisher.
+// It waits for the last batch to be published, unless the context is done first.
+func (p *LocationPublisher) Close(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.stop)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.done:
+		return nil
+	}
+}
+
+// run publishes the full batches and the buffered locations at every interval.
+func (p *LocationPublisher) run(ctx context.Context) {
+	defer close(p.done)
+
+	t := time.NewTicker(p.config.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.abort(ctx.Err())
+			return
+		case <-p.stop:
+			p.send(ctx, p.take())
+			return
+		case batch := <-p.full:
+			p.send(ctx, batch)
+		case <-t.C:
+			p.send(ctx, p.take())
+		}
+	}
+}
+
+// take removes the buffered locations from the publisher.
+func (p *LocationPublisher) take() locationBatch {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	batch := p.batch
+	if len(batch.payload) != 0 {
+		p.batch = p.newBatch()
+	}
+
+	return batch
+}
+
+// abort closes the publisher, reporting the queued and buffered locations to the handler.
+func (p *LocationPublisher) abort(err error) {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+
+	for {
+		select {
+		case batch := <-p.full:
+			p.config.onError(batch.locations, err)
+		default:
+			if batch := p.take(); len(batch.payload) != 0 {
+				p.config.onError(batch.locations, err)
+			}
+			return
+		}
+	}
+}
+
+// send publishes the batch in a single wrapper object, reporting any error to the handler.
+func (p *LocationPublisher) send(ctx context.Context, batch locationBatch) {
+	if len(batch.payload) == 0 {
+		return
+	}
+
+	if limiter := p.client.configuration.RateLimiter; limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			p.config.onError(batch.locations, err)
+			return
+		}
+	}
+
+	if err := p.client.Publish(ctx, TopicLocationUpdates, batch.payload...); err != nil {
+		p.config.onError(batch.locations, err)
+	}
+}
+
+func (p *LocationPublisher) newBatch() locationBatch {
+	return locationBatch{
+		locations: make([]Location, 0, p.config.size),
+		payload:   make([]json.RawMessage, 0, p.config.size),
+	}
+}
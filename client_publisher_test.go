@@ -0,0 +1,225 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// testLocations returns n valid locations of different providers.
+func testLocations(n int) []Location {
+	locations := make([]Location, 0, n)
+	for i := 0; i < n; i++ {
+		locations = append(locations, Location{
+			Source:       "fdb6df62-bce8-6c23-e342-80bd5c938774",
+			ProviderType: LocationProviderTypeUwb,
+			ProviderID:   fmt.Sprintf("77:4f:34:69:27:%02d", i),
+			Crs:          CrsLocal,
+		})
+	}
+	return locations
+}
+
+func TestPublishLocations(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	if err := c.PublishLocations(context.Background(), testLocations(3)...); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := hc.expect(t, EventMsg)
+	if msg.Topic != TopicLocationUpdates || len(msg.Payload) != 3 {
+		t.Errorf("published %d payloads to %q, want 3 to %q", len(msg.Payload), msg.Topic, TopicLocationUpdates)
+	}
+
+	invalid := testLocations(2)
+	invalid[1].ProviderID = ""
+
+	if err := c.PublishLocations(context.Background(), invalid...); !errors.Is(err, ErrInvalidLocation) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidLocation)
+	}
+}
+
+func TestLocationValidate(t *testing.T) {
+	valid := testLocations(1)[0]
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(l *Location)
+	}{
+		{"empty-source", func(l *Location) { l.Source = "" }},
+		{"empty-provider-id", func(l *Location) { l.ProviderID = "" }},
+		{"unknown-provider-type", func(l *Location) { l.ProviderType = 7 }},
+		{"negative-provider-type", func(l *Location) { l.ProviderType = -1 }},
+		{"invalid-crs", func(l *Location) { l.Crs = "wgs84" }},
+		{"negative-accuracy", func(l *Location) { l.Accuracy = opt(-1.0) }},
+		{"negative-speed", func(l *Location) { l.Speed = opt(-0.5) }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := valid
+			tc.modify(&l)
+
+			if err := l.Validate(); !errors.Is(err, ErrInvalidLocation) {
+				t.Errorf("got error %v, want %v", err, ErrInvalidLocation)
+			}
+		})
+	}
+}
+
+func TestLocationPublisherBatchSize(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	p, err := c.NewLocationPublisher(context.Background(), WithBatchSize(4), WithBatchInterval(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Publish(context.Background(), testLocations(10)...); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range []int{4, 4} {
+		if msg := hc.expect(t, EventMsg); len(msg.Payload) != n {
+			t.Errorf("batch of %d payloads, want %d", len(msg.Payload), n)
+		}
+	}
+
+	// the remaining locations are published when closed
+	if err := p.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if msg := hc.expect(t, EventMsg); len(msg.Payload) != 2 {
+		t.Errorf("last batch of %d payloads, want 2", len(msg.Payload))
+	}
+
+	if err := p.Publish(context.Background(), testLocations(1)...); !errors.Is(err, ErrPublisherClosed) {
+		t.Errorf("got error %v, want %v", err, ErrPublisherClosed)
+	}
+}
+
+func TestLocationPublisherInterval(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t, WithRateLimiter(rate.NewLimiter(rate.Inf, 1)))
+
+	p, err := c.NewLocationPublisher(context.Background(), WithBatchInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close(context.Background())
+
+	if err := p.Publish(context.Background(), testLocations(3)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if msg := hc.expect(t, EventMsg); len(msg.Payload) != 3 {
+		t.Errorf("batch of %d payloads, want 3", len(msg.Payload))
+	}
+}
+
+func TestLocationPublisherErrors(t *testing.T) {
+	hub := newFakeHub(t)
+	c, _ := hub.connect(t)
+
+	type batchErr struct {
+		batch []Location
+		err   error
+	}
+	errs := make(chan batchErr, 1)
+
+	p, err := c.NewLocationPublisher(
+		context.Background(),
+		WithBatchInterval(10*time.Millisecond),
+		WithBatchErrorHandler(func(batch []Location, err error) {
+			errs <- batchErr{batch, err}
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close(context.Background())
+
+	// publishing on a closed client fails
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Publish(context.Background(), testLocations(2)...); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case e := <-errs:
+		if len(e.batch) != 2 || e.err == nil {
+			t.Errorf("batch error reported %d locations with %v", len(e.batch), e.err)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for batch error")
+	}
+}
+
+func TestLocationPublisherCancel(t *testing.T) {
+	hub := newFakeHub(t)
+	c, _ := hub.connect(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 1)
+	var dropped []Location
+
+	p, err := c.NewLocationPublisher(
+		ctx,
+		WithBatchInterval(time.Hour),
+		WithBatchErrorHandler(func(batch []Location, err error) {
+			dropped = append(dropped, batch...)
+			errs <- err
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Publish(context.Background(), testLocations(3)...); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+
+	select {
+	case <-p.done:
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for the publisher to stop")
+	}
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+	default:
+		t.Fatal("buffered locations were not reported")
+	}
+
+	if len(dropped) != 3 {
+		t.Errorf("reported %d locations, want 3", len(dropped))
+	}
+
+	if err := p.Publish(context.Background(), testLocations(1)...); !errors.Is(err, ErrPublisherClosed) {
+		t.Errorf("got error %v, want %v", err, ErrPublisherClosed)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand"
+	"net"
+	"net/url"
+	"time"
+
+	"nhooyr.io/websocket"
+)
+
+// Errors
+var (
+	ErrReconnectAttempts = errors.New("reconnect attempts exhausted")
+)
+
+// ReconnectPolicy configures how a lost websocket connection is reestablished.
+// Zero-valued fields take the values of [DefaultReconnectPolicy].
+type ReconnectPolicy struct {
+	// InitialInterval is the delay before the first reconnection attempt.
+	//
+	// Default: 500ms
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between reconnection attempts.
+	//
+	// Default: 30s
+	MaxInterval time.Duration
+
+	// Multiplier by which the delay grows after each failed attempt.
+	//
+	// Default: 2
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to the given fraction (e.g. 0.2 is ±20%),
+	// so that clients don't reconnect in lockstep after an outage of the hub.
+	//
+	// Default: 0.2
+	Jitter float64
+
+	// MaxAttempts limits the number of consecutive failed attempts.
+	// Zero means retrying until the client is closed.
+	//
+	// Default: 0
+	MaxAttempts int
+}
+
+// DefaultReconnectPolicy returns the default reconnection policy.
+func DefaultReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+	}
+}
+
+// validate checks the policy and fills its zero-valued fields with the defaults.
+func (p *ReconnectPolicy) validate() error {
+	def := DefaultReconnectPolicy()
+
+	switch {
+	case p.InitialInterval < 0, p.MaxInterval < 0:
+		return fmt.Errorf("reconnect intervals must not be negative")
+	case p.Multiplier != 0 && p.Multiplier < 1:
+		return fmt.Errorf("reconnect multiplier must not be less than 1")
+	case p.Jitter < 0 || p.Jitter > 1:
+		return fmt.Errorf("reconnect jitter must be between 0 and 1")
+	case p.MaxAttempts < 0:
+		return fmt.Errorf("reconnect attempts must not be negative")
+	}
+
+	if p.InitialInterval == 0 {
+		p.InitialInterval = def.InitialInterval
+	}
+	if p.MaxInterval == 0 {
+		p.MaxInterval = def.MaxInterval
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = def.Multiplier
+	}
+	if p.Jitter == 0 {
+		p.Jitter = def.Jitter
+	}
+
+	return nil
+}
+
+// backoff returns the delay before the given reconnection attempt (starting at 0).
+func (p *ReconnectPolicy) backoff(attempt int) time.Duration {
+	return exponentialBackoff(p.InitialInterval, p.MaxInterval, p.Multiplier, p.Jitter, attempt)
+}
+
+// exponentialBackoff returns the delay before the given attempt (starting at 0),
+// growing from the initial interval by the multiplier up to the max interval
+// and randomized by the jitter fraction.
+func exponentialBackoff(initial, max time.Duration, multiplier, jitter float64, attempt int) time.Duration {
+	d := float64(initial) * math.Pow(multiplier, float64(attempt))
+	if d > float64(max) {
+		d = float64(max)
+	}
+
+	// randomize within [d - jitter*d, d + jitter*d]
+	d += d * jitter * (2*rand.Float64() - 1)
+
+	return time.Duration(d)
+}
+
+// reconnect redials the websocket interface with exponential backoff,
+// until it succeeds, the attempts are exhausted or the context is done.
+func (c *Client) reconnect(ctx context.Context, wsURL *url.URL, policy *ReconnectPolicy) (*websocket.Conn, error) {
+	var err error
+	for attempt := 0; policy.MaxAttempts == 0 || attempt < policy.MaxAttempts; attempt++ {
+		t := time.NewTimer(policy.backoff(attempt))
+
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+
+		var conn *websocket.Conn
+		conn, err = c.dial(ctx, wsURL)
+		if err != nil {
+			slog.LogAttrs(context.Background(), slog.LevelDebug, "reconnect failed", slog.Int("attempt", attempt+1), slog.Any("err", err))
+			continue
+		}
+
+		var change stateChange
+
+		c.mu.Lock()
+		stopped := c.stopped
+		if !stopped {
+			c.conn = conn
+			change = c.transition(StateConnected)
+		}
+		c.mu.Unlock()
+
+		c.notifyState(change)
+
+		// the client may have been closed while dialing
+		if stopped {
+			conn.CloseNow()
+			return nil, net.ErrClosed
+		}
+
+		return conn, nil
+	}
+
+	return nil, fmt.Errorf("%w: %w", ErrReconnectAttempts, err)
+}
+
+// resubscribe reissues the active subscriptions with their original parameters.
+// Subscriptions rejected by the server are ended with the server error.
+func (c *Client) resubscribe(ctx context.Context) {
+	c.mu.RLock()
+	subs := make([]*Subscription, 0, len(c.subs))
+	for sub := range c.subs {
+		subs = append(subs, sub)
+	}
+	c.mu.RUnlock()
+
+	for _, sub := range subs {
+		_, err := c.awaitSub(ctx, &pendingSub{
+			topic:    sub.topic,
+			params:   sub.params,
+			delivery: sub.delivery,
+			sub:      sub,
+			ack:      make(chan subAck, 1),
+		})
+		if err == nil {
+			continue
+		}
+
+		var wsErr WebsocketError
+		if !errors.As(err, &wsErr) && !errors.Is(err, ErrTimeout) {
+			// the connection was lost again, which will be retried
+			return
+		}
+
+		slog.LogAttrs(context.Background(), slog.LevelWarn, "resubscription failed", slog.String("topic", string(sub.topic)), slog.Any("err", err))
+
+		c.mu.Lock()
+		delete(c.subs, sub)
+		c.mu.Unlock()
+
+		sub.close(err)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"nhooyr.io/websocket"
+)
+
+func TestReconnectPolicyBackoff(t *testing.T) {
+	p := ReconnectPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Second,
+		Multiplier:      2,
+		Jitter:          0.1,
+	}
+	if err := p.validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 100 * time.Millisecond},
+		{1, 200 * time.Millisecond},
+		{3, 800 * time.Millisecond},
+		{4, time.Second},
+		{20, time.Second},
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < 100; i++ {
+			got := p.backoff(tc.attempt)
+			if lo, hi := tc.want*9/10, tc.want*11/10; got < lo || got > hi {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", tc.attempt, got, lo, hi)
+			}
+		}
+	}
+}
+
+func TestReconnectPolicyValidate(t *testing.T) {
+	var p ReconnectPolicy
+	if err := p.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if p != DefaultReconnectPolicy() {
+		t.Errorf("zero policy = %+v, want defaults %+v", p, DefaultReconnectPolicy())
+	}
+
+	invalid := []ReconnectPolicy{
+		{InitialInterval: -1},
+		{Multiplier: 0.5},
+		{Jitter: 2},
+		{MaxAttempts: -1},
+	}
+	for _, p := range invalid {
+		if err := p.validate(); err == nil {
+			t.Errorf("expected error for policy %+v", p)
+		}
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t, WithReconnect(ReconnectPolicy{InitialInterval: 10 * time.Millisecond}))
+
+	locs := subscribeOK(t, c, hc, TopicLocationUpdates, 1, WithCRS("local"))
+	fences := subscribeOK(t, c, hc, TopicFenceEvents, 2)
+
+	// the hub drops the connection
+	hc.conn.Close(websocket.StatusGoingAway, "restarting")
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	hc = hub.accept(ctx, t)
+
+	// subscriptions are reissued with their original parameters
+	for i := 0; i < 2; i++ {
+		req := hc.expect(t, EventSubscribe)
+
+		switch req.Topic {
+		case TopicLocationUpdates:
+			if req.Params[ParamCRS] != "local" {
+				t.Errorf("resubscribed with params %v, want crs local", req.Params)
+			}
+			ack, _ := json.Marshal(WrapperObject{Event: EventSubscribed, Topic: req.Topic, SubscriptionID: 5})
+			hc.send(t, string(ack))
+		case TopicFenceEvents:
+			hc.send(t, `{"event":"error","code":10004,"description":"not authorized","topic":"fence_events"}`)
+		default:
+			t.Fatalf("unexpected resubscription to %q", req.Topic)
+		}
+	}
+
+	// the subscription channel stays open across the reconnection
+	hc.send(t, `{"event":"message","topic":"location_updates","subscription_id":5,"payload":[{"n":1}]}`)
+	receiveOK(t, locs)
+
+	if locs.Err() != nil {
+		t.Errorf("subscription ended with %v", locs.Err())
+	}
+	if id := locs.ID(); id != 5 {
+		t.Errorf("subscription ID = %d, want 5", id)
+	}
+
+	// rejected resubscriptions end with the server error
+	select {
+	case <-fences.Done():
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for rejected subscription to end")
+	}
+
+	var wsErr WebsocketError
+	if err := fences.Err(); !errors.As(err, &wsErr) || wsErr.Code != ErrCodeNotAuthorized {
+		t.Errorf("rejected subscription ended with %v, want %v", err, ErrCodeNotAuthorized)
+	}
+}
+
+func TestReconnectDisabled(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	sub := subscribeOK(t, c, hc, TopicLocationUpdates, 1)
+
+	hc.conn.Close(websocket.StatusGoingAway, "restarting")
+
+	select {
+	case <-sub.Done():
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for subscription to end")
+	}
+
+	select {
+	case <-hub.conns:
+		t.Error("client reconnected without reconnect policy")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
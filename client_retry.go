@@ -0,0 +1,226 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Values of the [RetryPolicy] fields that disable a behaviour, as their zero value takes the default.
+const (
+	// NoJitter disables the randomization of the delays between retries.
+	NoJitter = -1
+
+	// NoRetries disables retrying requests.
+	NoRetries = -1
+)
+
+// RetryPolicy configures how REST requests that failed with a transient error
+// (e.g. a dropped connection or a 503 response) are retried.
+// Zero-valued fields take the values of [DefaultRetryPolicy].
+type RetryPolicy struct {
+	// InitialInterval is the delay before the first retry.
+	//
+	// Default: 250ms
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between retries.
+	// Longer delays requested by the hub with a Retry-After header are honoured.
+	//
+	// Default: 10s
+	MaxInterval time.Duration
+
+	// Multiplier by which the delay grows after each retry.
+	//
+	// Default: 2
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to the given fraction (e.g. 0.2 is ±20%).
+	// A negative value, such as [NoJitter], disables it.
+	//
+	// Default: 0.2
+	Jitter float64
+
+	// MaxRetries limits the number of retries of a request.
+	// A negative value, such as [NoRetries], disables retries.
+	//
+	// Default: 3
+	MaxRetries int
+
+	// StatusCodes are the response status codes that are retried.
+	//
+	// Default: 429, 502, 503, 504
+	StatusCodes []int
+
+	// RetryPOST enables retrying POST requests, which are not idempotent.
+	// Only GET, HEAD, OPTIONS, PUT and DELETE requests are retried otherwise.
+	//
+	// Default: false
+	RetryPOST bool
+}
+
+// DefaultRetryPolicy returns the default retry policy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialInterval: 250 * time.Millisecond,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxRetries:      3,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// validate checks the policy and fills its zero-valued fields with the defaults.
+// The negative jitter and retries are set to zero.
+func (p *RetryPolicy) validate() error {
+	def := DefaultRetryPolicy()
+
+	switch {
+	case p.InitialInterval < 0, p.MaxInterval < 0:
+		return fmt.Errorf("retry intervals must not be negative")
+	case p.Multiplier != 0 && p.Multiplier < 1:
+		return fmt.Errorf("retry multiplier must not be less than 1")
+	case p.Jitter > 1:
+		return fmt.Errorf("retry jitter must not be greater than 1")
+	}
+
+	if p.InitialInterval == 0 {
+		p.InitialInterval = def.InitialInterval
+	}
+	if p.MaxInterval == 0 {
+		p.MaxInterval = def.MaxInterval
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = def.Multiplier
+	}
+	switch {
+	case p.Jitter == 0:
+		p.Jitter = def.Jitter
+	case p.Jitter < 0:
+		p.Jitter = 0
+	}
+	switch {
+	case p.MaxRetries == 0:
+		p.MaxRetries = def.MaxRetries
+	case p.MaxRetries < 0:
+		p.MaxRetries = 0
+	}
+	if len(p.StatusCodes) == 0 {
+		p.StatusCodes = def.StatusCodes
+	} else {
+		p.StatusCodes = slices.Clone(p.StatusCodes)
+	}
+
+	return nil
+}
+
+// retryable reports if the request can be retried after the given response or error.
+func (p *RetryPolicy) retryable(ctx context.Context, req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	case http.MethodPost:
+		if !p.RetryPOST {
+			return false
+		}
+	default:
+		return false
+	}
+
+	// the body must be replayable
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		// canceled requests and certificate errors are not transient
+		var certErr *tls.CertificateVerificationError
+		return ctx.Err() == nil && !errors.As(err, &certErr)
+	}
+
+	return slices.Contains(p.StatusCodes, resp.StatusCode)
+}
+
+// delay returns the delay before the given retry (starting at 0),
+// honouring the Retry-After header of the response.
+func (p *RetryPolicy) delay(retry int, resp *http.Response) time.Duration {
+	d := exponentialBackoff(p.InitialInterval, p.MaxInterval, p.Multiplier, p.Jitter, retry)
+
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header.Get("Retry-After")); ok && after > d {
+			d = after
+		}
+	}
+
+	return d
+}
+
+// retryAfter parses the value of a Retry-After header, in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+// rewind returns a copy of the request with its body rewound, so that it can be resent.
+func rewind(req *http.Request) (*http.Request, error) {
+	retryReq := req.Clone(req.Context())
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("could not rewind request body: %w", err)
+		}
+		retryReq.Body = body
+	}
+
+	return retryReq, nil
+}
+
+// discard consumes and closes the body of a response that will not be returned,
+// so that its connection can be reused.
+func discard(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
+
+// sleep waits for the given duration, unless the context is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
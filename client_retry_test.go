@@ -0,0 +1,333 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// fastRetries is a retry policy for tests, without noticeable delays.
+var fastRetries = RetryPolicy{
+	InitialInterval: time.Millisecond,
+	MaxInterval:     5 * time.Millisecond,
+}
+
+// flakyHub responds to each request with the next handler, then with an empty list.
+type flakyHub struct {
+	mu       sync.Mutex
+	handlers []http.HandlerFunc
+	bodies   []string
+}
+
+func newFlakyHub(t *testing.T, handlers ...http.HandlerFunc) (*flakyHub, *httptest.Server) {
+	t.Helper()
+
+	h := &flakyHub{handlers: handlers}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		h.mu.Lock()
+		h.bodies = append(h.bodies, string(body))
+		var handler http.HandlerFunc
+		if len(h.handlers) != 0 {
+			handler, h.handlers = h.handlers[0], h.handlers[1:]
+		}
+		h.mu.Unlock()
+
+		if handler != nil {
+			handler(w, r)
+			return
+		}
+
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+
+	return h, srv
+}
+
+// requests returns the bodies of the requests received by the hub.
+func (h *flakyHub) requests() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.bodies
+}
+
+// status responds with the status code.
+func status(code int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(code)
+	}
+}
+
+// dropConnection closes the connection without responding.
+func dropConnection(w http.ResponseWriter, r *http.Request) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err == nil {
+		conn.Close()
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("transient", func(t *testing.T) {
+		hub, srv := newFlakyHub(t, status(http.StatusServiceUnavailable), dropConnection, status(http.StatusTooManyRequests))
+
+		c, err := New(srv.URL, WithRetryPolicy(fastRetries))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if n := len(hub.requests()); n != 4 {
+			t.Errorf("hub received %d requests, want 4", n)
+		}
+	})
+
+	t.Run("exhausted", func(t *testing.T) {
+		hub, srv := newFlakyHub(t,
+			status(http.StatusBadGateway),
+			status(http.StatusBadGateway),
+			status(http.StatusBadGateway),
+			status(http.StatusBadGateway),
+		)
+
+		c, err := New(srv.URL, WithRetryPolicy(fastRetries))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err == nil {
+			t.Fatal("request succeeded after the retries were exhausted")
+		}
+
+		if n := len(hub.requests()); n != 4 {
+			t.Errorf("hub received %d requests, want 4", n)
+		}
+	})
+
+	t.Run("no_retries", func(t *testing.T) {
+		hub, srv := newFlakyHub(t, status(http.StatusServiceUnavailable))
+
+		policy := fastRetries
+		policy.MaxRetries = NoRetries
+
+		c, err := New(srv.URL, WithRetryPolicy(policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if n := len(hub.requests()); n != 1 {
+			t.Errorf("hub received %d requests, want 1", n)
+		}
+	})
+
+	t.Run("not_retryable", func(t *testing.T) {
+		hub, srv := newFlakyHub(t, status(http.StatusInternalServerError))
+
+		c, err := New(srv.URL, WithRetryPolicy(fastRetries))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if n := len(hub.requests()); n != 1 {
+			t.Errorf("hub received %d requests, want 1", n)
+		}
+	})
+
+	t.Run("post", func(t *testing.T) {
+		hub, srv := newFlakyHub(t, status(http.StatusServiceUnavailable))
+
+		c, err := New(srv.URL, WithRetryPolicy(fastRetries))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.Create(ctx, Zone{Name: "zone"}); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if n := len(hub.requests()); n != 1 {
+			t.Errorf("hub received %d requests, want 1", n)
+		}
+	})
+
+	t.Run("post_opt_in", func(t *testing.T) {
+		hub, srv := newFlakyHub(t, status(http.StatusServiceUnavailable), func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"name":"zone"}`)
+		})
+
+		policy := fastRetries
+		policy.RetryPOST = true
+
+		c, err := New(srv.URL, WithRetryPolicy(policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.Create(ctx, Zone{Name: "zone"}); err != nil {
+			t.Fatal(err)
+		}
+
+		// the body is replayed on every attempt
+		bodies := hub.requests()
+		if len(bodies) != 2 || bodies[0] == "" || bodies[0] != bodies[1] {
+			t.Errorf("hub received bodies %q, want two equal bodies", bodies)
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		// the hub asks to retry beyond the request timeout
+		hub, srv := newFlakyHub(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		c, err := New(srv.URL, WithRetryPolicy(fastRetries), WithRequestTimeout(time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if n := len(hub.requests()); n != 1 {
+			t.Errorf("hub received %d requests, want 1", n)
+		}
+	})
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		jitter  float64
+		retries int
+	}{
+		{"defaults", RetryPolicy{}, 0.2, 3},
+		{"explicit", RetryPolicy{Jitter: 0.5, MaxRetries: 1}, 0.5, 1},
+		{"disabled", RetryPolicy{Jitter: NoJitter, MaxRetries: NoRetries}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.policy
+			if err := p.validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			if p.Jitter != tt.jitter {
+				t.Errorf("jitter = %v, want %v", p.Jitter, tt.jitter)
+			}
+			if p.MaxRetries != tt.retries {
+				t.Errorf("max retries = %d, want %d", p.MaxRetries, tt.retries)
+			}
+		})
+	}
+
+	invalid := []RetryPolicy{
+		{InitialInterval: -1},
+		{MaxInterval: -1},
+		{Multiplier: 0.5},
+		{Jitter: 1.5},
+	}
+
+	for _, p := range invalid {
+		if err := p.validate(); err == nil {
+			t.Errorf("expected error for policy %+v", p)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	tests := []struct {
+		name       string
+		retryAfter string
+		min, max   time.Duration
+	}{
+		{"backoff", "", 200 * time.Millisecond, 300 * time.Millisecond},
+		{"seconds", "3", 3 * time.Second, 3 * time.Second},
+		{"date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), 59 * time.Minute, time.Hour},
+		{"past_date", "Mon, 02 Jan 2006 15:04:05 GMT", 200 * time.Millisecond, 300 * time.Millisecond},
+		{"invalid", "soon", 200 * time.Millisecond, 300 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+
+			if d := policy.delay(0, resp); d < tt.min || d > tt.max {
+				t.Errorf("delay = %v, want between %v and %v", d, tt.min, tt.max)
+			}
+		})
+	}
+
+	t.Run("no_jitter", func(t *testing.T) {
+		policy := RetryPolicy{Jitter: NoJitter}
+		if err := policy.validate(); err != nil {
+			t.Fatal(err)
+		}
+
+		for retry := 0; retry < 3; retry++ {
+			if d, want := policy.delay(retry, nil), 250*time.Millisecond<<retry; d != want {
+				t.Errorf("delay of retry %d = %v, want %v", retry, d, want)
+			}
+		}
+	})
+}
+
+func TestRateLimiterError(t *testing.T) {
+	_, srv := newFlakyHub(t)
+
+	// a limiter without burst rejects every request
+	c, err := New(srv.URL, WithRateLimiter(rate.NewLimiter(1, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Zones.List(context.Background()); err == nil {
+		t.Fatal("expected rate limiter error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c, err = New(srv.URL, WithRateLimiter(rate.NewLimiter(rate.Every(time.Hour), 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Zones.List(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"time"
+)
+
+// ConnectionState is the state of the websocket connection to the Omlox™ Hub.
+type ConnectionState int
+
+// Defines values for ConnectionState.
+const (
+	// StateClosed is the state of a client which isn't connected, was closed or lost its connection.
+	StateClosed ConnectionState = iota
+	// StateConnecting is the state of a client dialing the hub for the first time.
+	StateConnecting
+	// StateConnected is the state of a client with an open connection to the hub.
+	StateConnected
+	// StateReconnecting is the state of a client reestablishing a lost connection (see [WithReconnect]).
+	StateReconnecting
+)
+
+// String return a text representation.
+func (s ConnectionState) String() string {
+	states := [...]string{
+		"closed",
+		"connecting",
+		"connected",
+		"reconnecting",
+	}
+
+	if len(states) <= int(s) {
+		return ""
+	}
+
+	return states[s]
+}
+
+// State returns the current state of the websocket connection.
+func (c *Client) State() ConnectionState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// OnStateChange registers a function to be called on every state change of the websocket connection.
+// The function is called synchronously, in the order of the changes, so it must not block
+// nor call [Client.Connect] or [Client.Close].
+func (c *Client) OnStateChange(fn func(state ConnectionState)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stateHandlers = append(c.stateHandlers, fn)
+}
+
+// Latency returns the round-trip time of the last heartbeat to the hub.
+// It is zero while the client is not connected or before the first heartbeat.
+func (c *Client) Latency() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.latency
+}
+
+// setState changes the connection state, notifying the registered handlers.
+func (c *Client) setState(state ConnectionState) {
+	c.mu.Lock()
+	change := c.transition(state)
+	c.mu.Unlock()
+
+	c.notifyState(change)
+}
+
+// stateChange is a connection state transition, whose handlers are notified once the client lock is released.
+type stateChange struct {
+	state    ConnectionState
+	seq      uint64
+	handlers []func(ConnectionState)
+}
+
+// transition changes the connection state, returning the change to notify once the lock is released.
+// The client lock must be held by the caller, which must then call [Client.notifyState].
+func (c *Client) transition(state ConnectionState) stateChange {
+	if c.state == state {
+		return stateChange{}
+	}
+
+	c.state = state
+	if state != StateConnected {
+		c.latency = 0
+	}
+
+	c.stateSeq++
+	return stateChange{state: state, seq: c.stateSeq, handlers: c.stateHandlers}
+}
+
+// notifyState calls the handlers with the new state, once the previous changes were notified,
+// so that concurrent transitions are delivered in the order they happened.
+func (c *Client) notifyState(change stateChange) {
+	if change.seq == 0 {
+		return
+	}
+
+	c.notifyMu.Lock()
+	defer c.notifyMu.Unlock()
+
+	for c.notifiedSeq+1 != change.seq {
+		c.notified.Wait()
+	}
+
+	// the next change is notified even if a handler panics
+	defer c.notified.Broadcast()
+	defer func() { c.notifiedSeq = change.seq }()
+
+	for _, fn := range change.handlers {
+		fn(change.state)
+	}
+}
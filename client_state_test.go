@@ -0,0 +1,141 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"nhooyr.io/websocket"
+)
+
+// expectStates waits for the given sequence of state changes.
+func expectStates(t *testing.T, states <-chan ConnectionState, want ...ConnectionState) {
+	t.Helper()
+
+	for _, w := range want {
+		select {
+		case got := <-states:
+			if got != w {
+				t.Fatalf("got state %q, want %q", got, w)
+			}
+		case <-time.After(testTimeout):
+			t.Fatalf("timeout waiting for state %q", w)
+		}
+	}
+}
+
+func TestConnectionState(t *testing.T) {
+	hub := newFakeHub(t)
+
+	c, err := New(hub.srv.URL, WithReconnect(ReconnectPolicy{InitialInterval: 10 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := c.State(); s != StateClosed {
+		t.Errorf("new client state = %q, want %q", s, StateClosed)
+	}
+
+	states := make(chan ConnectionState, 16)
+	c.OnStateChange(func(s ConnectionState) {
+		states <- s
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	hc := hub.accept(ctx, t)
+
+	expectStates(t, states, StateConnecting, StateConnected)
+
+	if s := c.State(); s != StateConnected {
+		t.Errorf("state = %q, want %q", s, StateConnected)
+	}
+
+	hc.conn.Close(websocket.StatusGoingAway, "restarting")
+	hub.accept(ctx, t)
+
+	expectStates(t, states, StateReconnecting, StateConnected)
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectStates(t, states, StateClosed)
+
+	if s := c.State(); s != StateClosed {
+		t.Errorf("closed client state = %q, want %q", s, StateClosed)
+	}
+}
+
+func TestConnectionStateDialError(t *testing.T) {
+	hub := newFakeHub(t)
+	hub.srv.Close()
+
+	c, err := New(hub.srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states := make(chan ConnectionState, 16)
+	c.OnStateChange(func(s ConnectionState) {
+		states <- s
+	})
+
+	if err := c.Connect(context.Background()); err == nil {
+		t.Fatal("expected dial error")
+	}
+
+	expectStates(t, states, StateConnecting, StateClosed)
+}
+
+func TestConnectionStateOrder(t *testing.T) {
+	c, err := New("http://localhost")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu       sync.Mutex
+		notified []ConnectionState
+	)
+	c.OnStateChange(func(s ConnectionState) {
+		runtime.Gosched()
+		mu.Lock()
+		notified = append(notified, s)
+		mu.Unlock()
+	})
+
+	states := []ConnectionState{StateConnecting, StateConnected, StateReconnecting, StateClosed}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				c.setState(states[(i+j)%len(states)])
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// every change is a transition from the previous state
+	for i := 1; i < len(notified); i++ {
+		if notified[i] == notified[i-1] {
+			t.Fatalf("state %q notified twice in a row at change %d", notified[i], i)
+		}
+	}
+
+	if n := len(notified); n == 0 || notified[n-1] != c.State() {
+		t.Errorf("last notified state differs from state %q", c.State())
+	}
+}
@@ -4,25 +4,76 @@
 package omlox
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/mailru/easyjson"
 )
 
 const (
+	// default number of messages buffered by subscriptions.
 	receiveChanSize = 256
 )
 
-// Subcription represents a topic subscription to the websocket Hub interface.
-type Subcription struct {
+// Errors
+var (
+	ErrUnsubscribed = errors.New("unsubscribed")
+)
+
+// Subscription represents a topic subscription to the websocket Hub interface.
+type Subscription struct {
+	client *Client
+
 	sid int
 
-	topic  Topic
-	params Parameters
+	topic    Topic
+	params   Parameters
+	delivery delivery
+
+	// buffers the messages until they are received through the message channel.
+	queue *msgQueue
+	mch   chan *WrapperObject
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+// Subcription represents a topic subscription to the websocket Hub interface.
+//
+// Deprecated: use Subscription instead.
+type Subcription = Subscription
+
+// newSubscription returns an active subscription.
+func newSubscription(c *Client, sid int, topic Topic, params Parameters, d delivery) *Subscription {
+	sub := &Subscription{
+		client:   c,
+		sid:      sid,
+		topic:    topic,
+		params:   params,
+		delivery: d,
+		queue:    newMsgQueue(d),
+		mch:      make(chan *WrapperObject),
+		done:     make(chan struct{}),
+	}
+
+	go sub.pump()
+
+	return sub
+}
 
-	mch chan *WrapperObject
+// ReceiveAs decodes the subscription payloads into T, with its easyjson decoder if available.
+// Payloads that cannot be decoded are skipped.
+func ReceiveAs[T any](sub *Subscription) <-chan *T {
+	return receive(sub, unmarshalJSON[T])
 }
 
-func ReceiveAs[T any](sub *Subcription) <-chan *T {
-	out := make(chan *T, receiveChanSize)
+// receive decodes the subscription payloads with the given decoder function.
+// It stops when the subscription ends, even if the consumer stopped receiving.
+func receive[T any](sub *Subscription, decode func([]byte, *T) error) <-chan *T {
+	// unbuffered, the messages are buffered by the subscription
+	out := make(chan *T)
 
 	go func() {
 		defer close(out)
@@ -30,11 +81,15 @@ func ReceiveAs[T any](sub *Subcription) <-chan *T {
 		for msg := range sub.mch {
 			for _, payload := range msg.Payload {
 				var v T
-				if err := json.Unmarshal(payload, &v); err != nil {
+				if err := decode(payload, &v); err != nil {
 					continue
 				}
 
-				out <- &v
+				select {
+				case <-sub.done:
+					return
+				case out <- &v:
+				}
 			}
 		}
 	}()
@@ -42,10 +97,161 @@ func ReceiveAs[T any](sub *Subcription) <-chan *T {
 	return out
 }
 
-func (s Subcription) ReceiveRaw() <-chan *WrapperObject {
+// TypedSubscription represents a topic subscription whose payloads are decoded into omlox™ objects of type T.
+// Its lifecycle is bound to the underlying [Subscription].
+type TypedSubscription[T any] struct {
+	sub *Subscription
+	out <-chan *T
+}
+
+// easyjsonPtr constrains a type parameter to a pointer of T implementing the easyjson unmarshaler.
+type easyjsonPtr[T any] interface {
+	*T
+	easyjson.Unmarshaler
+}
+
+// newTypedSubscription starts decoding the subscription payloads using the easyjson decoder of T.
+func newTypedSubscription[T any, PT easyjsonPtr[T]](sub *Subscription) *TypedSubscription[T] {
+	return &TypedSubscription[T]{
+		sub: sub,
+		out: receive(sub, func(data []byte, v *T) error {
+			return easyjson.Unmarshal(data, PT(v))
+		}),
+	}
+}
+
+// Receive returns the channel of decoded payloads.
+// Payloads that cannot be decoded are skipped.
+// The channel is closed when the subscription ends.
+func (s *TypedSubscription[T]) Receive() <-chan *T {
+	return s.out
+}
+
+// Topic returns the subscription topic.
+func (s *TypedSubscription[T]) Topic() Topic {
+	return s.sub.topic
+}
+
+// Done returns a channel that is closed when the subscription ends.
+func (s *TypedSubscription[T]) Done() <-chan struct{} {
+	return s.sub.Done()
+}
+
+// Err returns the reason why the subscription ended (see [Subscription.Err]).
+func (s *TypedSubscription[T]) Err() error {
+	return s.sub.Err()
+}
+
+// Dropped returns the number of messages dropped by the subscription (see [Subscription.Dropped]).
+func (s *TypedSubscription[T]) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe from the subscription topic (see [Subscription.Unsubscribe]).
+func (s *TypedSubscription[T]) Unsubscribe(ctx context.Context) error {
+	return s.sub.Unsubscribe(ctx)
+}
+
+// ReceiveRaw returns the channel of messages received by the subscription.
+// The channel is closed when the subscription ends.
+func (s *Subscription) ReceiveRaw() <-chan *WrapperObject {
 	return s.mch
 }
 
-func (s *Subcription) close() {
-	close(s.mch)
+// ID returns the subscription ID assigned by the server.
+// It is zero for hubs that do not report subscription IDs
+// and may change when the subscription is reissued after a reconnection.
+func (s *Subscription) ID() int {
+	if s.client == nil {
+		return s.sid
+	}
+
+	s.client.mu.RLock()
+	defer s.client.mu.RUnlock()
+	return s.sid
+}
+
+// Topic returns the subscription topic.
+func (s *Subscription) Topic() Topic {
+	return s.topic
+}
+
+// Done returns a channel that is closed when the subscription ends.
+// The reason can be retrieved with [Subscription.Err].
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns nil while the subscription is active.
+// After Done is closed, Err returns the reason why the subscription ended:
+// [ErrUnsubscribed] after an unsubscription, [net.ErrClosed] when the client
+// was closed or the error that caused the connection to fail.
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Unsubscribe from the subscription topic.
+// It waits for the server confirmation, unless the context is done first.
+// Regardless of the server response, the subscription is ended and its channel closed.
+func (s *Subscription) Unsubscribe(ctx context.Context) error {
+	select {
+	case <-s.done:
+		return nil
+	default:
+	}
+
+	return s.client.unsubscribe(ctx, s)
+}
+
+// Dropped returns the number of messages dropped by the subscription
+// because its buffer was full (see [WithOverflow]) or because it ended
+// before they were received.
+func (s *Subscription) Dropped() uint64 {
+	return s.queue.dropped.Load()
+}
+
+// deliver buffers the message for the subscription, applying its overflow strategy.
+// It reports if the message was buffered.
+func (s *Subscription) deliver(ctx context.Context, msg *WrapperObject) bool {
+	select {
+	case <-s.done:
+		return false
+	default:
+	}
+
+	return s.queue.push(ctx, msg, s.done)
+}
+
+// pump sends the buffered messages to the subscription channel until the subscription ends.
+// The messages still buffered by then are counted as dropped.
+func (s *Subscription) pump() {
+	defer close(s.mch)
+	defer s.queue.close()
+
+	for {
+		msg, ok := s.queue.pop(s.done)
+		if !ok {
+			return
+		}
+
+		select {
+		case <-s.done:
+			s.queue.dropped.Add(1)
+			return
+		case s.mch <- msg:
+		}
+	}
+}
+
+// close ends the subscription with the given reason.
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+	})
 }
@@ -0,0 +1,195 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// receiveN waits for n decoded values.
+func receiveN[T any](t *testing.T, ch <-chan *T, n int) []T {
+	t.Helper()
+
+	got := make([]T, 0, n)
+	for len(got) < n {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				t.Fatalf("channel closed after %d values, want %d", len(got), n)
+			}
+			got = append(got, *v)
+		case <-time.After(testTimeout):
+			t.Fatalf("timeout after %d values, want %d", len(got), n)
+		}
+	}
+
+	return got
+}
+
+// receiveAsOK checks that the payloads received by a subscription are decoded as the expected values.
+func receiveAsOK[T any](t *testing.T, topic Topic, payloads [][]byte, expected []T) {
+	t.Helper()
+
+	sub := newSubscription(nil, 0, topic, nil, defaultDelivery)
+	defer sub.close(ErrUnsubscribed)
+
+	wrObj := &WrapperObject{Event: EventMsg, Topic: topic}
+	for _, p := range payloads {
+		wrObj.Payload = append(wrObj.Payload, json.RawMessage(p))
+	}
+
+	sub.deliver(context.Background(), wrObj)
+
+	got := receiveN(t, ReceiveAs[T](sub), len(expected))
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("ReceiveAs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReceiveAs(t *testing.T) {
+	t.Run("location_updates", func(t *testing.T) {
+		var (
+			payloads [][]byte
+			expected []Location
+		)
+		for _, tc := range locationJSONTestCases {
+			payloads = append(payloads, tc.json)
+			expected = append(expected, tc.location)
+		}
+		receiveAsOK(t, TopicLocationUpdates, payloads, expected)
+	})
+
+	t.Run("fence_events", func(t *testing.T) {
+		var (
+			payloads [][]byte
+			expected []FenceEvent
+		)
+		for _, tc := range fenceEventJSONTestCases {
+			payloads = append(payloads, tc.json)
+			expected = append(expected, tc.event)
+		}
+		receiveAsOK(t, TopicFenceEvents, payloads, expected)
+	})
+
+	t.Run("collision_events", func(t *testing.T) {
+		var (
+			payloads [][]byte
+			expected []CollisionEvent
+		)
+		for _, tc := range collisionEventJSONTestCases {
+			payloads = append(payloads, tc.json)
+			expected = append(expected, tc.event)
+		}
+		receiveAsOK(t, TopicCollisionEvents, payloads, expected)
+	})
+
+	t.Run("trackable_motions", func(t *testing.T) {
+		var (
+			payloads [][]byte
+			expected []TrackableMotion
+		)
+		for _, tc := range trackableMotionJSONTestCases {
+			payloads = append(payloads, tc.json)
+			expected = append(expected, tc.motion)
+		}
+		receiveAsOK(t, TopicTrackableMotions, payloads, expected)
+	})
+}
+
+func TestTypedSubscription(t *testing.T) {
+	sub := newSubscription(nil, 0, TopicLocationUpdates, nil, defaultDelivery)
+
+	wrObj := &WrapperObject{Event: EventMsg, Topic: TopicLocationUpdates}
+	for _, tc := range locationJSONTestCases {
+		wrObj.Payload = append(wrObj.Payload, json.RawMessage(tc.json))
+	}
+	// invalid payloads are skipped
+	wrObj.Payload = append(wrObj.Payload, json.RawMessage(`{"position":"invalid"}`))
+
+	sub.deliver(context.Background(), wrObj)
+
+	typed := newTypedSubscription[Location](sub)
+	if typed.Topic() != TopicLocationUpdates {
+		t.Errorf("topic = %q, want %q", typed.Topic(), TopicLocationUpdates)
+	}
+
+	expected := make([]Location, 0, len(locationJSONTestCases))
+	for _, tc := range locationJSONTestCases {
+		expected = append(expected, tc.location)
+	}
+
+	got := receiveN(t, typed.Receive(), len(expected))
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Receive() mismatch (-want +got):\n%s", diff)
+	}
+
+	sub.close(ErrUnsubscribed)
+
+	// the channel is closed when the subscription ends
+	if _, ok := <-typed.Receive(); ok {
+		t.Error("received value after the subscription ended")
+	}
+}
+
+func TestSubscriptionEndDropped(t *testing.T) {
+	sub := newSubscription(nil, 0, TopicLocationUpdates, nil, defaultDelivery)
+
+	for i := 0; i < 3; i++ {
+		if !sub.deliver(context.Background(), &WrapperObject{Event: EventMsg, Topic: TopicLocationUpdates}) {
+			t.Fatalf("message %d not buffered", i)
+		}
+	}
+
+	sub.close(ErrUnsubscribed)
+
+	// a message may still be sent while the subscription ends, the others are dropped
+	received := 0
+	for range sub.ReceiveRaw() {
+		received++
+	}
+
+	if n := received + int(sub.Dropped()); n != 3 {
+		t.Errorf("received %d and dropped %d messages, want 3 in total", received, sub.Dropped())
+	}
+
+	if sub.deliver(context.Background(), &WrapperObject{Event: EventMsg, Topic: TopicLocationUpdates}) {
+		t.Error("message buffered after the subscription ended")
+	}
+}
+
+func TestReceiveAsStopped(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	sub := newSubscription(nil, 0, TopicLocationUpdates, nil, defaultDelivery)
+	for i := 0; i < 3; i++ {
+		sub.deliver(context.Background(), &WrapperObject{
+			Event:   EventMsg,
+			Topic:   TopicLocationUpdates,
+			Payload: []json.RawMessage{json.RawMessage(locationJSONTestCases[0].json)},
+		})
+	}
+
+	// the consumer stops receiving after the first location
+	locations := ReceiveAs[Location](sub)
+	receiveN(t, locations, 1)
+
+	sub.close(ErrUnsubscribed)
+
+	// the subscription goroutines end without the consumer receiving
+	deadline := time.Now().Add(testTimeout)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines running after the subscription ended, want %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
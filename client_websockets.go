@@ -11,17 +11,16 @@ import (
 	"io"
 	"log/slog"
 	"net"
+	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/mailru/easyjson"
 	"golang.org/x/sync/errgroup"
 	"nhooyr.io/websocket"
-	"nhooyr.io/websocket/wsjson"
 )
 
 const (
-	chanSendTimeout = 100 * time.Millisecond
-
 	// time allowed to read the next pong message from the peer.
 	pongWait = 10 * time.Second
 	// send pings to peer with this period. Must be less than pongWait.
@@ -45,6 +44,8 @@ var (
 )
 
 // wrapperObject is an internal abstraction of the websockets data exchange object.
+//
+//easyjson:json
 type wrapperObject struct {
 	// Embedded error fields. Will only be present on error: `event` is error.
 	WebsocketError
@@ -70,8 +71,12 @@ func Connect(ctx context.Context, addr string, options ...ClientOption) (*Client
 }
 
 // Connect dials the Omlox™ Hub websockets interface.
+//
+// The context bounds the lifetime of the connection. If the client was configured
+// with [WithReconnect], the connection is reestablished whenever it is lost, until
+// the client is closed or the context is done. The first dial is never retried.
 func (c *Client) Connect(ctx context.Context) error {
-	if !c.isClosed() {
+	if c.isActive() {
 		// close the connection if it happens to be open
 		if err := c.Close(); err != nil {
 			return err
@@ -87,12 +92,54 @@ func (c *Client) Connect(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	errg, ctx := errgroup.WithContext(ctx)
 
+	c.setState(StateConnecting)
+
+	conn, err := c.dial(ctx, wsURL)
+	if err != nil {
+		cancel()
+		c.setState(StateClosed)
+		return err
+	}
+
+	c.mu.Lock()
+	c.conn = conn
+	c.stopped = false
+	c.errg = errg
+	c.cancel = cancel
+	change := c.transition(StateConnected)
+	c.mu.Unlock()
+
+	c.notifyState(change)
+
+	c.errg.Go(func() error {
+		return c.run(ctx, wsURL, conn)
+	})
+
+	return nil
+}
+
+// dial opens a websocket connection to the given URL.
+func (c *Client) dial(ctx context.Context, wsURL *url.URL) (*websocket.Conn, error) {
+	header := make(http.Header)
+
+	if c.configuration.UserAgent != "" {
+		header.Set("User-Agent", c.configuration.UserAgent)
+	}
+
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		header.Set("Authorization", token.authorization())
+	}
+
 	conn, _, err := websocket.Dial(ctx, wsURL.String(), &websocket.DialOptions{
 		HTTPClient: c.client,
+		HTTPHeader: header,
 	})
 	if err != nil {
-		cancel()
-		return err
+		return nil, err
 	}
 
 	slog.LogAttrs(
@@ -104,25 +151,82 @@ func (c *Client) Connect(ctx context.Context) error {
 		slog.Bool("secured", wsURL.Scheme == wsSchemeTLS),
 	)
 
-	c.mu.Lock()
-	c.conn = conn
-	c.closed = false
-	c.errg = errg
-	c.cancel = cancel
-	c.mu.Unlock()
+	return conn, nil
+}
 
-	c.errg.Go(func() error {
-		return c.readLoop(ctx)
+// run serves the connection until the client is closed.
+// Lost connections are reestablished if the client was configured to reconnect.
+// Subscriptions are ended when it returns.
+func (c *Client) run(ctx context.Context, wsURL *url.URL, conn *websocket.Conn) (err error) {
+	defer func() {
+		c.clearSubs(err)
+	}()
+
+	for resubscribe := false; ; resubscribe = true {
+		err = c.serve(ctx, conn, resubscribe)
+
+		policy := c.configuration.Reconnect
+		if policy == nil || ctx.Err() != nil || c.isStopped() {
+			c.disconnect(StateClosed)
+			return err
+		}
+
+		slog.LogAttrs(context.Background(), slog.LevelDebug, "connection lost", slog.Any("err", err))
+
+		c.disconnect(StateReconnecting)
+
+		conn, err = c.reconnect(ctx, wsURL, policy)
+		if err != nil {
+			c.setState(StateClosed)
+
+			if ctx.Err() != nil || c.isStopped() {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// serve handles the connection until it fails or is closed.
+// When resubscribe is set, the active subscriptions are reissued on the connection.
+func (c *Client) serve(ctx context.Context, conn *websocket.Conn, resubscribe bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	defer conn.CloseNow()
+
+	errg, ctx := errgroup.WithContext(ctx)
+
+	errg.Go(func() error {
+		// the connection ends with the read loop
+		defer cancel()
+		return c.readLoop(ctx, conn)
 	})
 
-	c.errg.Go(func() error {
-		return c.pingLoop(ctx)
+	errg.Go(func() error {
+		return c.pingLoop(ctx, conn)
 	})
 
-	return nil
+	if resubscribe {
+		errg.Go(func() error {
+			c.resubscribe(ctx)
+			return nil
+		})
+	}
+
+	if spool := c.configuration.Spool; spool != nil {
+		errg.Go(func() error {
+			c.replay(ctx, spool)
+			return nil
+		})
+	}
+
+	return errg.Wait()
 }
 
 // Publish a message to the Omlox Hub.
+// If the client has a spool, the payloads are spooled as is while the client is disconnected
+// (unlike [Client.PublishLocations] and [Client.PublishProximities], they are not timestamped).
 func (c *Client) Publish(ctx context.Context, topic Topic, payload ...json.RawMessage) error {
 	if topic == "" {
 		return errors.New("empty topic")
@@ -134,100 +238,556 @@ func (c *Client) Publish(ctx context.Context, topic Topic, payload ...json.RawMe
 		Payload: payload,
 	}
 
-	return c.publish(ctx, wrObj)
+	if spool := c.configuration.Spool; spool != nil {
+		return c.publishSpooled(ctx, spool, wrObj)
+	}
+
+	return c.publishTracked(ctx, wrObj)
+}
+
+// publishTracked publishes the message, numbering it before it is written
+// so that errors of the hub read in the meantime are correlated to it.
+func (c *Client) publishTracked(ctx context.Context, wrObj *WrapperObject) error {
+	mark := c.trackPublished(wrObj.Topic)
+
+	if err := c.publish(ctx, wrObj); err != nil {
+		c.untrackPublished(mark)
+		return err
+	}
+
+	return nil
+}
+
+// publishSpooled publishes the message, or queues it in the spool while the client is disconnected.
+// Messages are also queued while older ones wait to be replayed, so that they are published in order.
+func (c *Client) publishSpooled(ctx context.Context, spool Spool, wrObj *WrapperObject) error {
+	if spool.Len() == 0 {
+		err := c.publishTracked(ctx, wrObj)
+		if err == nil {
+			return nil
+		}
+
+		// messages are not spooled after the client was closed,
+		// nor when they failed for reasons other than the connection being down
+		if ctx.Err() != nil || c.isStopped() || !isConnectionLost(err) {
+			return err
+		}
+	}
+
+	if err := spool.Push(wrObj); err != nil {
+		return err
+	}
+
+	signal(c.spooled)
+	return nil
+}
+
+// isConnectionLost reports whether the error is due to the connection to the hub being down.
+func isConnectionLost(err error) bool {
+	if errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	// the TCP connection failed while writing
+	var e *net.OpError
+	if errors.As(err, &e) {
+		return true
+	}
+
+	return websocket.CloseStatus(err) != -1
+}
+
+// replay publishes the spooled messages in order, whenever there are any,
+// until the context is done.
+func (c *Client) replay(ctx context.Context, spool Spool) {
+	for {
+		if err := c.drainSpool(ctx, spool); err != nil {
+			slog.LogAttrs(context.Background(), slog.LevelDebug, "spool replay interrupted", slog.Any("err", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.spooled:
+		}
+	}
+}
+
+// drainSpool publishes the spooled messages until the spool is empty.
+// Messages that fail for a reason other than the connection being down are rejected,
+// so that they don't block the spool, and reported to the error handlers.
+func (c *Client) drainSpool(ctx context.Context, spool Spool) error {
+	for {
+		msg, err := spool.Peek()
+		if err != nil || msg == nil {
+			return err
+		}
+
+		if err := c.publishTracked(ctx, msg); err != nil {
+			if ctx.Err() != nil || isConnectionLost(err) {
+				return err
+			}
+
+			if err := spool.Reject(); err != nil {
+				return err
+			}
+
+			c.notifyError(&HubError{Topic: msg.Topic, Request: msg, Err: err})
+			continue
+		}
+
+		if err := spool.Pop(); err != nil {
+			return err
+		}
+	}
+}
+
+// PublishProximities publishes proximity updates to the Omlox Hub.
+// If the client has a spool, proximities without timestamp_generated are stamped
+// with the current time, so that it is kept if they are replayed later.
+func (c *Client) PublishProximities(ctx context.Context, proximities ...Proximity) error {
+	payload := make([]json.RawMessage, 0, len(proximities))
+
+	now := time.Now().UTC()
+
+	for _, p := range proximities {
+		if c.configuration.Spool != nil && p.TimestampGenerated == nil {
+			p.TimestampGenerated = &now
+		}
+
+		b, err := p.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		payload = append(payload, b)
+	}
+
+	return c.Publish(ctx, TopicProximityUpdates, payload...)
+}
+
+// PublishLocations publishes location updates to the Omlox Hub.
+// The locations are validated before being published (see [Location.Validate]).
+// If the client has a spool, locations without timestamp_generated are stamped
+// with the current time, so that it is kept if they are replayed later.
+func (c *Client) PublishLocations(ctx context.Context, locations ...Location) error {
+	payload, err := c.marshalLocations(locations)
+	if err != nil {
+		return err
+	}
+
+	return c.Publish(ctx, TopicLocationUpdates, payload...)
+}
+
+// marshalLocations validates and encodes the locations as websocket payloads.
+func (c *Client) marshalLocations(locations []Location) ([]json.RawMessage, error) {
+	payload := make([]json.RawMessage, 0, len(locations))
+
+	now := time.Now().UTC()
+
+	for i, l := range locations {
+		if err := l.Validate(); err != nil {
+			return nil, fmt.Errorf("location %d: %w", i, err)
+		}
+
+		if c.configuration.Spool != nil && l.TimestampGenerated == nil {
+			l.TimestampGenerated = &now
+		}
+
+		b, err := l.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		payload = append(payload, b)
+	}
+
+	return payload, nil
 }
 
 func (c *Client) publish(ctx context.Context, wrObj *WrapperObject) (err error) {
 	// TODO @dvcorreia: maybe this log should be a metric instead.
-	defer slog.LogAttrs(context.Background(), slog.LevelDebug, "published", slog.Any("err", err), slog.Any("event", wrObj))
+	if slog.Default().Enabled(ctx, slog.LevelDebug) {
+		defer func() {
+			slog.LogAttrs(context.Background(), slog.LevelDebug, "published", slog.Any("err", err), slog.Any("event", wrObj))
+		}()
+	}
+
+	c.mu.RLock()
+	conn, state := c.conn, c.state
+	c.mu.RUnlock()
 
-	if c.isClosed() {
+	if state != StateConnected {
 		return net.ErrClosed
 	}
 
-	// TODO @dvcorreia: use the easyjson marshal method.
-	return wsjson.Write(ctx, c.conn, wrObj)
+	authorized, err := c.authorize(ctx, wrObj)
+	if err != nil {
+		return err
+	}
+	if authorized != wrObj {
+		defer putWrapper(authorized)
+	}
+
+	return writeMessage(ctx, conn, authorized)
+}
+
+// writeMessage encodes the wrapper object into a websocket message, through a pooled buffer.
+func writeMessage(ctx context.Context, conn *websocket.Conn, wrObj *WrapperObject) error {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	if err := writeJSON(buf, wrObj); err != nil {
+		return err
+	}
+
+	return conn.Write(ctx, websocket.MessageText, buf.Bytes())
 }
 
 // Subscribe to a topic in Omlox Hub.
-func (c *Client) Subscribe(ctx context.Context, topic Topic, params ...Parameter) (*Subcription, error) {
-	parameters := make(Parameters)
-	for _, param := range params {
-		if err := param(topic, parameters); err != nil {
-			return nil, err
-		}
+// Messages are delivered with the default buffering (see [Client.SubscribeWith]).
+func (c *Client) Subscribe(ctx context.Context, topic Topic, params ...Parameter) (*Subscription, error) {
+	opts := make([]SubscribeOption, 0, len(params))
+	for _, p := range params {
+		opts = append(opts, p)
+	}
+
+	return c.SubscribeWith(ctx, topic, opts...)
+}
+
+// SubscribeWith subscribes to a topic in Omlox Hub.
+// The options set the subscription parameters (see [Parameter]) and
+// how messages are delivered to the subscription (see [DeliveryOption]).
+func (c *Client) SubscribeWith(ctx context.Context, topic Topic, opts ...SubscribeOption) (*Subscription, error) {
+	cfg, err := newSubscribeConfig(topic, opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	return c.subscribe(ctx, topic, parameters)
+	return c.subscribe(ctx, topic, cfg.params, cfg.delivery)
+}
+
+// SubscribeLocationUpdates subscribes to the location_updates topic in Omlox Hub.
+// The payloads are decoded into omlox™ Location objects.
+func (c *Client) SubscribeLocationUpdates(ctx context.Context, opts ...SubscribeOption) (*TypedSubscription[Location], error) {
+	return subscribeTyped[Location](ctx, c, TopicLocationUpdates, opts...)
+}
+
+// SubscribeFenceEvents subscribes to the fence_events topic in Omlox Hub.
+// The payloads are decoded into omlox™ FenceEvent objects.
+func (c *Client) SubscribeFenceEvents(ctx context.Context, opts ...SubscribeOption) (*TypedSubscription[FenceEvent], error) {
+	return subscribeTyped[FenceEvent](ctx, c, TopicFenceEvents, opts...)
+}
+
+// SubscribeCollisionEvents subscribes to the collision_events topic in Omlox Hub.
+// The payloads are decoded into omlox™ CollisionEvent objects.
+func (c *Client) SubscribeCollisionEvents(ctx context.Context, opts ...SubscribeOption) (*TypedSubscription[CollisionEvent], error) {
+	return subscribeTyped[CollisionEvent](ctx, c, TopicCollisionEvents, opts...)
+}
+
+// SubscribeTrackableMotions subscribes to the trackable_motions topic in Omlox Hub.
+// The payloads are decoded into omlox™ TrackableMotion objects.
+func (c *Client) SubscribeTrackableMotions(ctx context.Context, opts ...SubscribeOption) (*TypedSubscription[TrackableMotion], error) {
+	return subscribeTyped[TrackableMotion](ctx, c, TopicTrackableMotions, opts...)
+}
+
+// subscribeTyped subscribes to a topic and decodes its payloads into T.
+func subscribeTyped[T any, PT easyjsonPtr[T]](ctx context.Context, c *Client, topic Topic, opts ...SubscribeOption) (*TypedSubscription[T], error) {
+	sub, err := c.SubscribeWith(ctx, topic, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTypedSubscription[T, PT](sub), nil
 }
 
 // Sends a subscription message and handles the confirmation from the server.
 //
-// The subscription will be attributed an ID that can used for futher context.
-// There can only be one pending subscription at each time.
-// Subsequent subscriptions will wait while the pending one is waiting for an ID from the server.
-// Since each subscription on a topic can have a distinct parameters, we must synchronisly wait to match each one to its ID.
-func (c *Client) subscribe(ctx context.Context, topic Topic, params Parameters) (*Subcription, error) {
-	// channel to await subscription confirmation
-	await := make(chan struct {
-		sid int
-		err error
+// Several subscriptions can be awaiting confirmation at the same time.
+// Confirmations are matched to the pending subscriptions in order, by topic
+// and parameters (when the server includes them in the confirmation).
+// The subscription is registered by the read loop as soon as it is confirmed,
+// so that no message sent right after the confirmation is lost.
+// Requests that time out or are canceled are kept until confirmed, so that their
+// late confirmation isn't matched to another subscription, and are then unsubscribed.
+func (c *Client) subscribe(ctx context.Context, topic Topic, params Parameters, d delivery) (*Subscription, error) {
+	return c.awaitSub(ctx, &pendingSub{
+		topic:    topic,
+		params:   params,
+		delivery: d,
+		ack:      make(chan subAck, 1),
 	})
-	defer close(await)
+}
 
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	// lock for pending subscription confirmation.
-	// the pending will be freed by the subribed message handler.
-	case c.pending <- await:
-	}
+// awaitSub sends the subscription request and waits for its confirmation.
+func (c *Client) awaitSub(ctx context.Context, pending *pendingSub) (*Subscription, error) {
+	pending.published = c.publishedSeq()
+
+	c.mu.Lock()
+	c.pending = append(c.pending, pending)
+	c.mu.Unlock()
 
 	wrObj := &WrapperObject{
 		Event:   EventSubscribe,
-		Topic:   topic,
-		Params:  params,
+		Topic:   pending.topic,
+		Params:  pending.params,
 		Payload: nil,
 	}
 
 	if err := c.publish(ctx, wrObj); err != nil {
-		<-c.pending // clear pending subscription
+		c.removePending(pending)
 		return nil, err
 	}
 
-	// wait for subcription ID
-	var r struct {
-		sid int
-		err error
+	// wait for subcription confirmation
+	var timeout <-chan time.Time
+	if d := c.configuration.SubscriptionTimeout; d > 0 {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		timeout = t.C
 	}
+
+	var (
+		ack subAck
+		err error
+	)
 	select {
 	case <-ctx.Done():
-		return nil, ctx.Err()
-	case r = <-await:
+		err = ctx.Err()
+	case <-timeout:
+		err = fmt.Errorf("subscription to topic '%s' was not confirmed: %w", pending.topic, ErrTimeout)
+	case ack = <-pending.ack:
 	}
 
-	if r.err != nil {
-		return nil, r.err
+	// the confirmation may have raced with the cancelation
+	if err != nil && !c.abandonPending(pending) {
+		ack = <-pending.ack
+		err = nil
 	}
 
-	sub := &Subcription{
-		// sid:    r.sid,
-		sid:    0, // BUG: deephub doesn't return the sid in subsequent messages (NEEDS FIX!)
-		topic:  topic,
-		params: params,
-		mch:    make(chan *WrapperObject, 1),
+	if err != nil {
+		return nil, err
 	}
 
-	// promote a pending subcription
+	return ack.sub, ack.err
+}
+
+// pendingSub is a subscription awaiting confirmation from the server.
+type pendingSub struct {
+	topic    Topic
+	params   Parameters
+	delivery delivery
+
+	// subscription being reissued after a reconnection, if any.
+	sub *Subscription
+
+	// set when the request timed out or was canceled, while the server may still confirm it.
+	// The entry is kept as a tombstone, so that its confirmation isn't taken for another one.
+	abandoned bool
+
+	// sequence number of the last message published before the subscription request,
+	// to tell whether errors without topic may be caused by a published message instead.
+	published uint64
+
+	// receives the result of the subscription (buffered).
+	ack chan subAck
+}
+
+// subAck is the result of a subscription request.
+type subAck struct {
+	sub *Subscription
+	err error
+}
+
+// removePending removes a pending subscription, reporting whether it was still pending.
+func (c *Client) removePending(pending *pendingSub) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, p := range c.pending {
+		if p == pending {
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// abandonPending marks a pending subscription as abandoned, reporting whether it was still pending.
+func (c *Client) abandonPending(pending *pendingSub) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, p := range c.pending {
+		if p == pending {
+			p.abandoned = true
+			return true
+		}
+	}
+
+	return false
+}
+
+// popPending removes and returns the oldest pending subscription matching the topic and parameters.
+// Empty topic or parameters match any pending subscription.
+// The client lock must be held by the caller.
+func (c *Client) popPending(topic Topic, params Parameters) *pendingSub {
+	for i, p := range c.pending {
+		if (topic == "" || topic == p.topic) && (len(params) == 0 || equalParameters(params, p.params)) {
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			return p
+		}
+	}
+
+	return nil
+}
+
+// resolveSub confirms the oldest pending subscription matching the message and registers it in the client.
+// Late confirmations of abandoned subscriptions are consumed and unsubscribed from.
+func (c *Client) resolveSub(ctx context.Context, msg *WrapperObject) {
 	c.mu.Lock()
-	c.subs[sub.sid] = sub
+	defer c.mu.Unlock()
+
+	pending := c.popPending(msg.Topic, msg.Params)
+	if pending == nil {
+		slog.LogAttrs(context.Background(), slog.LevelWarn, "unexpected subscription confirmation", slog.Any("event", msg))
+		return
+	}
+
+	if pending.abandoned {
+		// not sent from the read loop, which must not block
+		go c.discardSub(ctx, pending.topic, msg.SubscriptionID)
+		return
+	}
+
+	sub := pending.sub
+	if sub == nil {
+		sub = newSubscription(c, msg.SubscriptionID, pending.topic, pending.params, pending.delivery)
+		c.subs[sub] = struct{}{}
+	} else if _, ok := c.subs[sub]; ok {
+		// the subscription may have ended while being reissued
+		sub.sid = msg.SubscriptionID
+	}
+
+	pending.ack <- subAck{sub: sub} // buffered
+}
+
+// discardSub unsubscribes from a subscription confirmed after its request was abandoned.
+func (c *Client) discardSub(ctx context.Context, topic Topic, sid int) {
+	if sid == 0 {
+		slog.LogAttrs(context.Background(), slog.LevelWarn, "abandoned subscription confirmed without subscription id", slog.String("topic", string(topic)))
+		return
+	}
+
+	wrObj := &WrapperObject{
+		Event:          EventUnsubscribe,
+		Topic:          topic,
+		SubscriptionID: sid,
+	}
+
+	if err := c.publish(ctx, wrObj); err != nil {
+		slog.LogAttrs(context.Background(), slog.LevelDebug, "abandoned subscription not unsubscribed", slog.Int("sid", sid), slog.Any("err", err))
+	}
+}
+
+// rejectSub fails the oldest pending subscription to the topic, returning it if there was one.
+// An error without topic only fails a pending subscription if no message was published since
+// it was requested, as it is otherwise ambiguous and attributed to the published message.
+// The error is correlated to the subscription request.
+func (c *Client) rejectSub(topic Topic, err *HubError) *pendingSub {
+	published := c.publishedSeq()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var pending *pendingSub
+	for i, p := range c.pending {
+		if topic == p.topic || (topic == "" && published == p.published) {
+			pending = p
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			break
+		}
+	}
+
+	if pending == nil {
+		return nil
+	}
+
+	err.Subscription = pending.sub
+	err.Request = &WrapperObject{
+		Event:  EventSubscribe,
+		Topic:  pending.topic,
+		Params: pending.params,
+	}
+
+	pending.ack <- subAck{err: err} // buffered
+	return pending
+}
+
+// Sends an unsubscription message and waits for the confirmation from the server.
+//
+// The subscription is ended and removed from the client regardless of the server response,
+// so that it is never left half-closed.
+func (c *Client) unsubscribe(ctx context.Context, sub *Subscription) error {
+	// channel to await unsubscription confirmation
+	await := make(chan error, 1)
+
+	c.mu.Lock()
+	sid := sub.sid
+	c.unsubs[sid] = await
 	c.mu.Unlock()
 
-	return sub, nil
+	defer func() {
+		c.mu.Lock()
+		if c.unsubs[sid] == await {
+			delete(c.unsubs, sid)
+		}
+		delete(c.subs, sub)
+		c.mu.Unlock()
+
+		sub.close(ErrUnsubscribed)
+	}()
+
+	wrObj := &WrapperObject{
+		Event:          EventUnsubscribe,
+		Topic:          sub.topic,
+		SubscriptionID: sid,
+	}
+
+	if err := c.publish(ctx, wrObj); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-await:
+		return err
+	}
+}
+
+// resolveUnsub sends the result to the pending unsubscription with the given subscription ID.
+// If the server didn't send the subscription ID and there is only one pending unsubscription, it is assumed to be it.
+func (c *Client) resolveUnsub(sid int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	await, ok := c.unsubs[sid]
+	if !ok && sid == 0 && len(c.unsubs) == 1 {
+		for id, ch := range c.unsubs {
+			sid, await, ok = id, ch, true
+		}
+	}
+
+	if !ok {
+		return
+	}
+
+	delete(c.unsubs, sid)
+	await <- err // buffered
 }
 
 // ping pong loop that manages the websocket connection health.
-func (c *Client) pingLoop(ctx context.Context) error {
+func (c *Client) pingLoop(ctx context.Context, conn *websocket.Conn) error {
 	t := time.NewTicker(pingPeriod)
 	defer t.Stop()
 
@@ -242,7 +802,7 @@ func (c *Client) pingLoop(ctx context.Context) error {
 		defer cancel()
 
 		begin := time.Now()
-		err := c.conn.Ping(ctx)
+		err := conn.Ping(ctx)
 
 		if err != nil {
 			// context was exceded and the client should close
@@ -259,23 +819,20 @@ func (c *Client) pingLoop(ctx context.Context) error {
 			return err
 		}
 
-		slog.Debug("heartbeat", slog.Duration("latency", time.Since(begin)))
+		latency := time.Since(begin)
+
+		c.mu.Lock()
+		c.latency = latency
+		c.mu.Unlock()
+
+		slog.Debug("heartbeat", slog.Duration("latency", latency))
 	}
 }
 
 // readLoop that will handle incomming data.
-func (c *Client) readLoop(ctx context.Context) error {
-	defer c.clearSubs()
-
-	// set the client to closed state
-	defer func() {
-		c.mu.Lock()
-		defer c.mu.Unlock()
-		c.closed = true
-	}()
-
+func (c *Client) readLoop(ctx context.Context, conn *websocket.Conn) error {
 	for {
-		msgType, r, err := c.conn.Reader(ctx)
+		msgType, r, err := conn.Reader(ctx)
 
 		if err != nil {
 			if errors.Is(err, context.Canceled) {
@@ -307,133 +864,190 @@ func (c *Client) readLoop(ctx context.Context) error {
 			continue
 		}
 
-		var (
-			wrObj wrapperObject
-			d     = json.NewDecoder(r) // TODO @dvcorreia: maybe use easyjson
-		)
-		if err := d.Decode(&wrObj); err != nil {
+		wrObj, err := readMessage(r)
+		if err != nil {
 			// TODO @dvcorreia: print debug logs or provide metrics
 			continue
 		}
 
-		slog.LogAttrs(context.Background(), slog.LevelDebug, "received", slog.Any("event", wrObj))
+		if slog.Default().Enabled(ctx, slog.LevelDebug) {
+			slog.LogAttrs(context.Background(), slog.LevelDebug, "received", slog.Any("event", wrObj))
+		}
+
+		c.handleMessage(ctx, wrObj)
+	}
+}
+
+// readMessage decodes a websocket message into a wrapper object, through a pooled buffer.
+func readMessage(r io.Reader) (*wrapperObject, error) {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
 
-		c.handleMessage(ctx, &wrObj)
+	var wrObj wrapperObject
+	if err := easyjson.Unmarshal(buf.Bytes(), &wrObj); err != nil {
+		return nil, err
 	}
+
+	return &wrObj, nil
 }
 
 // handleMessage received from the Omlox Hub server.
 func (c *Client) handleMessage(ctx context.Context, msg *wrapperObject) {
 	switch msg.Event {
 	case EventError:
-		c.handleError(ctx, msg)
+		c.handleError(msg)
 	case EventSubscribed:
-		// pop pending subscription and assign subscription ID
-		pendingc := <-c.pending
-		chsend(ctx, pendingc, struct {
-			sid int
-			err error
-		}{
-			sid: msg.SubscriptionID,
-		})
-		return
+		c.resolveSub(ctx, &msg.WrapperObject)
 	case EventUnsubscribed:
-		// TODO @dvcorreia: close subscription
+		c.resolveUnsub(msg.SubscriptionID, nil)
 	default:
 		c.routeMessage(ctx, &msg.WrapperObject)
 	}
 }
 
-// handleError handles any websocket error sent by the server.
-func (c *Client) handleError(ctx context.Context, msg *wrapperObject) {
-	switch msg.WebsocketError.Code {
-	case ErrCodeSubscription, ErrCodeNotAuthorized, ErrCodeUnknownTopic, ErrCodeInvalid:
-		// pop pending subscription and kill it
-		pendingc := <-c.pending
-		chsend(ctx, pendingc, struct {
-			sid int
-			err error
-		}{
-			err: msg.WebsocketError,
-		})
+// routeMessage sends the message to the its respective subscriptions.
+func (c *Client) routeMessage(ctx context.Context, msg *WrapperObject) {
+	subs := c.lookupSubs(msg)
+
+	if len(subs) == 0 {
+		slog.LogAttrs(context.Background(), slog.LevelDebug, "message without subscription", slog.Any("event", msg))
 		return
-	case ErrCodeUnknown: // TODO @dvcorreia: handle error
-	case ErrCodeUnsubscription: // TODO @dvcorreia: handle error
+	}
+
+	for _, sub := range subs {
+		if !sub.deliver(ctx, msg) {
+			slog.LogAttrs(
+				context.Background(),
+				slog.LevelDebug,
+				"message dropped by subscription",
+				slog.Any("event", msg),
+			)
+		}
 	}
 }
 
-// routeMessage sends the message to the its respective subscription.
-func (c *Client) routeMessage(ctx context.Context, msg *WrapperObject) {
-	// retrive subcription if exists
+// lookupSubs returns the subscriptions to which the message is addressed.
+//
+// Messages are routed by subscription ID. Some hubs omit the subscription ID
+// in messages (or confirmations), in which case the messages are matched
+// to subscriptions by topic and parameters (when present in the message).
+// Messages that can't be told apart are sent to all matching subscriptions.
+func (c *Client) lookupSubs(msg *WrapperObject) []*Subscription {
 	c.mu.RLock()
-	sub := c.subs[msg.SubscriptionID]
-	c.mu.RUnlock()
+	defer c.mu.RUnlock()
 
-	if sub == nil {
-		// TODO @dvcorreia: handle unknown subscription IDs
-		return
+	if msg.SubscriptionID != 0 {
+		for sub := range c.subs {
+			if sub.sid == msg.SubscriptionID {
+				return []*Subscription{sub}
+			}
+		}
 	}
 
-	select {
-	case <-ctx.Done():
-		return
-	case sub.mch <- msg: // TODO @dvcorreia: this will block other messages
-	case <-time.After(chanSendTimeout):
-		slog.LogAttrs(
-			context.Background(),
-			slog.LevelWarn,
-			"timeout sending to subscription channel",
-			slog.Any("event", msg),
-		)
+	var subs []*Subscription
+	for sub := range c.subs {
+		// subscriptions known by their ID only receive messages addressed to them
+		if msg.SubscriptionID != 0 && sub.sid != 0 {
+			continue
+		}
+
+		if msg.Topic != "" && msg.Topic != sub.topic {
+			continue
+		}
+
+		if len(msg.Params) != 0 && !equalParameters(msg.Params, sub.params) {
+			continue
+		}
+
+		subs = append(subs, sub)
 	}
+
+	return subs
 }
 
-// clearSubs closes resources of subscriptions.
-func (c *Client) clearSubs() {
+// disconnect sets the client to the given state, failing the requests awaiting the server.
+// Subscriptions are kept, so that they can be reissued after a reconnection.
+func (c *Client) disconnect(state ConnectionState) {
 	c.mu.Lock()
+	change := c.transition(state)
+	defer c.notifyState(change)
 	defer c.mu.Unlock()
 
-	for sid, sub := range c.subs {
-		sub.close()
-		delete(c.subs, sid)
+	// subscription IDs are only valid within a connection
+	for sub := range c.subs {
+		sub.sid = 0
 	}
 
-	// close any pending subscription
-	select {
-	case pending := <-c.pending:
-		pending <- struct {
-			sid int
-			err error
-		}{
-			err: net.ErrClosed,
-		}
-	default:
+	// resolve any pending unsubscription
+	for sid, await := range c.unsubs {
+		await <- net.ErrClosed
+		delete(c.unsubs, sid)
+	}
+
+	// fail any pending subscription
+	for _, pending := range c.pending {
+		pending.ack <- subAck{err: net.ErrClosed}
+	}
+	c.pending = nil
+}
+
+// clearSubs closes resources of subscriptions, ending them with the given reason.
+func (c *Client) clearSubs(err error) {
+	if err == nil {
+		err = net.ErrClosed
 	}
 
-	close(c.pending)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for sub := range c.subs {
+		sub.close(err)
+		delete(c.subs, sub)
+	}
 }
 
 // Close releases any resources held by the client,
 // such as connections, memory and goroutines.
 func (c *Client) Close() error {
-	if !c.isClosed() {
-		err := c.conn.Close(websocket.StatusNormalClosure, "")
-		if err != nil {
+	c.mu.Lock()
+	c.stopped = true
+	conn, state, cancel, errg := c.conn, c.state, c.cancel, c.errg
+	c.mu.Unlock()
+
+	if cancel == nil {
+		// never connected
+		return nil
+	}
+
+	if state == StateConnected {
+		err := conn.Close(websocket.StatusNormalClosure, "")
+		if err != nil && !errors.Is(err, net.ErrClosed) {
 			return err
 		}
 	}
 
 	// close the client context
-	c.cancel()
+	cancel()
+
+	return errg.Wait()
+}
 
-	return c.errg.Wait()
+// isStopped reports if the client was closed by the user.
+func (c *Client) isStopped() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.stopped
 }
 
-// isClosed reports if the client closed.
-func (c *Client) isClosed() bool {
+// isActive reports if the client is connected or reconnecting.
+func (c *Client) isActive() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.closed
+	return c.state != StateClosed
 }
 
 func upgradeToWebsocketScheme(u *url.URL) error {
@@ -458,12 +1072,3 @@ func (w wrapperObject) LogValue() slog.Value {
 	}
 	return w.WrapperObject.LogValue()
 }
-
-// chsend sends a value to channel with context cancelation.
-func chsend[T any](ctx context.Context, ch chan T, v T) {
-	select {
-	case <-ctx.Done():
-		return
-	case ch <- v:
-	}
-}
@@ -0,0 +1,444 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const testTimeout = 5 * time.Second
+
+// fakeHub is a minimal Omlox Hub websocket server used to test the client.
+type fakeHub struct {
+	srv   *httptest.Server
+	conns chan *hubConn
+}
+
+// hubConn is a client connection accepted by the fake hub.
+type hubConn struct {
+	conn *websocket.Conn
+	recv chan *WrapperObject
+}
+
+func newFakeHub(t testing.TB) *fakeHub {
+	t.Helper()
+
+	h := &fakeHub{
+		conns: make(chan *hubConn, 8),
+	}
+
+	h.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		hc := &hubConn{
+			conn: conn,
+			recv: make(chan *WrapperObject, 64),
+		}
+		h.conns <- hc
+
+		defer close(hc.recv)
+		for {
+			var wrObj WrapperObject
+			if err := wsjson.Read(r.Context(), conn, &wrObj); err != nil {
+				return
+			}
+			hc.recv <- &wrObj
+		}
+	}))
+	t.Cleanup(h.srv.Close)
+
+	return h
+}
+
+// connect dials the fake hub and returns the client and the accepted hub connection.
+func (h *fakeHub) connect(t testing.TB, options ...ClientOption) (*Client, *hubConn) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	c, err := Connect(context.Background(), h.srv.URL, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return c, h.accept(ctx, t)
+}
+
+// accept waits for the next client connection.
+func (h *fakeHub) accept(ctx context.Context, t testing.TB) *hubConn {
+	t.Helper()
+
+	select {
+	case hc := <-h.conns:
+		return hc
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for client connection")
+		return nil
+	}
+}
+
+// expect waits for the next message from the client and checks its event type.
+func (hc *hubConn) expect(t testing.TB, event Event) *WrapperObject {
+	t.Helper()
+
+	select {
+	case wrObj, ok := <-hc.recv:
+		if !ok {
+			t.Fatal("connection closed")
+		}
+		if wrObj.Event != event {
+			t.Fatalf("got event %q, want %q", wrObj.Event, event)
+		}
+		return wrObj
+	case <-time.After(testTimeout):
+		t.Fatalf("timeout waiting for %q event", event)
+		return nil
+	}
+}
+
+// send writes a raw JSON message to the client.
+func (hc *hubConn) send(t testing.TB, msg string) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	if err := hc.conn.Write(ctx, websocket.MessageText, []byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// subscribeOK subscribes to the topic, acknowledging the subscription with the given ID.
+func subscribeOK(t testing.TB, c *Client, hc *hubConn, topic Topic, sid int, opts ...SubscribeOption) *Subscription {
+	t.Helper()
+
+	type result struct {
+		sub *Subscription
+		err error
+	}
+	res := make(chan result, 1)
+
+	go func() {
+		sub, err := c.SubscribeWith(context.Background(), topic, opts...)
+		res <- result{sub, err}
+	}()
+
+	req := hc.expect(t, EventSubscribe)
+	if req.Topic != topic {
+		t.Fatalf("subscribed to topic %q, want %q", req.Topic, topic)
+	}
+
+	ack, _ := json.Marshal(WrapperObject{Event: EventSubscribed, Topic: topic, SubscriptionID: sid})
+	hc.send(t, string(ack))
+
+	r := <-res
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+
+	return r.sub
+}
+
+// receiveOK waits for a message on the subscription.
+func receiveOK(t *testing.T, sub *Subscription) *WrapperObject {
+	t.Helper()
+
+	select {
+	case msg, ok := <-sub.ReceiveRaw():
+		if !ok {
+			t.Fatal("subscription channel closed")
+		}
+		return msg
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for subscription message")
+		return nil
+	}
+}
+
+// doneOK waits for the subscription to end and checks the reason.
+func doneOK(t *testing.T, sub *Subscription, reason error) {
+	t.Helper()
+
+	select {
+	case <-sub.Done():
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for subscription to end")
+	}
+
+	if err := sub.Err(); !errors.Is(err, reason) {
+		t.Errorf("subscription ended with %v, want %v", err, reason)
+	}
+
+	if _, ok := <-sub.ReceiveRaw(); ok {
+		t.Error("subscription channel not closed")
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	sub := subscribeOK(t, c, hc, TopicLocationUpdates, 7)
+
+	if sub.Err() != nil {
+		t.Fatalf("active subscription has error: %v", sub.Err())
+	}
+
+	hc.send(t, `{"event":"message","topic":"location_updates","subscription_id":7,"payload":[{"provider_id":"77:4f:34:69:27:40"}]}`)
+	receiveOK(t, sub)
+
+	res := make(chan error, 1)
+	go func() {
+		res <- sub.Unsubscribe(context.Background())
+	}()
+
+	req := hc.expect(t, EventUnsubscribe)
+	if req.SubscriptionID != 7 {
+		t.Errorf("unsubscribed ID %d, want 7", req.SubscriptionID)
+	}
+	hc.send(t, `{"event":"unsubscribed","subscription_id":7}`)
+
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+
+	doneOK(t, sub, ErrUnsubscribed)
+
+	c.mu.RLock()
+	n := len(c.subs)
+	c.mu.RUnlock()
+	if n != 0 {
+		t.Errorf("client has %d subscriptions after unsubscribe", n)
+	}
+
+	// unsubscribing an ended subscription is a no-op
+	if err := sub.Unsubscribe(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnsubscribeError(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	sub := subscribeOK(t, c, hc, TopicFenceEvents, 3)
+
+	res := make(chan error, 1)
+	go func() {
+		res <- sub.Unsubscribe(context.Background())
+	}()
+
+	hc.expect(t, EventUnsubscribe)
+	hc.send(t, `{"event":"error","code":10003,"description":"unknown subscription","subscription_id":3}`)
+
+	var wsErr WebsocketError
+	if err := <-res; !errors.As(err, &wsErr) || wsErr.Code != ErrCodeUnsubscription {
+		t.Fatalf("got error %v, want %v", err, ErrCodeUnsubscription)
+	}
+
+	doneOK(t, sub, ErrUnsubscribed)
+}
+
+func TestCloseEndsSubscriptions(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	sub := subscribeOK(t, c, hc, TopicLocationUpdates, 1)
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	doneOK(t, sub, net.ErrClosed)
+}
+
+func TestSubscribeConcurrent(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	type result struct {
+		sub *Subscription
+		err error
+	}
+	res := make(map[Topic]chan result)
+
+	topics := []Topic{TopicLocationUpdates, TopicFenceEvents}
+	for _, topic := range topics {
+		ch := make(chan result, 1)
+		res[topic] = ch
+
+		go func(topic Topic) {
+			sub, err := c.Subscribe(context.Background(), topic)
+			ch <- result{sub, err}
+		}(topic)
+	}
+
+	// both subscriptions are pending before any confirmation
+	sids := make(map[Topic]int)
+	for i := range topics {
+		req := hc.expect(t, EventSubscribe)
+		sids[req.Topic] = i + 1
+	}
+
+	// confirm in reverse order of the requests
+	for i := len(topics) - 1; i >= 0; i-- {
+		ack, _ := json.Marshal(WrapperObject{Event: EventSubscribed, Topic: topics[i], SubscriptionID: sids[topics[i]]})
+		hc.send(t, string(ack))
+	}
+
+	subs := make(map[int]*Subscription)
+	for _, topic := range topics {
+		r := <-res[topic]
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if r.sub.Topic() != topic || r.sub.sid != sids[topic] {
+			t.Fatalf("subscription to %q got topic %q with ID %d, want ID %d", topic, r.sub.Topic(), r.sub.sid, sids[topic])
+		}
+		subs[r.sub.sid] = r.sub
+	}
+
+	// messages are routed by subscription ID, regardless of the topic
+	for sid, sub := range subs {
+		hc.send(t, fmt.Sprintf(`{"event":"message","subscription_id":%d,"payload":[{"n":%d}]}`, sid, sid))
+
+		msg := receiveOK(t, sub)
+		if msg.SubscriptionID != sid {
+			t.Errorf("subscription %d received message for %d", sid, msg.SubscriptionID)
+		}
+	}
+}
+
+func TestRouteWithoutSubscriptionID(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	// hubs that omit the subscription ID
+	locs := subscribeOK(t, c, hc, TopicLocationUpdates, 0)
+	fences := subscribeOK(t, c, hc, TopicFenceEvents, 0)
+
+	hc.send(t, `{"event":"message","topic":"fence_events","payload":[{"n":1}]}`)
+	receiveOK(t, fences)
+
+	hc.send(t, `{"event":"message","topic":"location_updates","payload":[{"n":2}]}`)
+	receiveOK(t, locs)
+
+	select {
+	case msg := <-fences.ReceiveRaw():
+		t.Errorf("fence_events subscription received unexpected message %v", msg)
+	default:
+	}
+}
+
+func TestSubscribeTimeout(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t, WithSubscriptionTimeout(50*time.Millisecond))
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicLocationUpdates)
+		res <- err
+	}()
+
+	hc.expect(t, EventSubscribe)
+
+	if err := <-res; !errors.Is(err, ErrTimeout) {
+		t.Fatalf("got error %v, want %v", err, ErrTimeout)
+	}
+
+	// kept until confirmed, so that the confirmation isn't taken for another subscription
+	c.mu.RLock()
+	n := len(c.pending)
+	c.mu.RUnlock()
+	if n != 1 {
+		t.Errorf("client has %d pending subscriptions after timeout, want 1", n)
+	}
+
+	subs := make(chan *Subscription, 1)
+	go func() {
+		sub, err := c.Subscribe(context.Background(), TopicLocationUpdates)
+		if err != nil {
+			t.Error(err)
+		}
+		subs <- sub
+	}()
+	hc.expect(t, EventSubscribe)
+
+	// the late confirmation is consumed and unsubscribed from
+	hc.send(t, `{"event":"subscribed","topic":"location_updates","subscription_id":1}`)
+	if msg := hc.expect(t, EventUnsubscribe); msg.SubscriptionID != 1 {
+		t.Errorf("unsubscribed from subscription %d, want 1", msg.SubscriptionID)
+	}
+
+	hc.send(t, `{"event":"subscribed","topic":"location_updates","subscription_id":2}`)
+	if sub := <-subs; sub == nil || sub.sid != 2 {
+		t.Fatalf("got subscription %v, want subscription 2", sub)
+	}
+
+	c.mu.RLock()
+	n = len(c.pending)
+	c.mu.RUnlock()
+	if n != 0 {
+		t.Errorf("client has %d pending subscriptions, want 0", n)
+	}
+}
+
+func TestSubscribeError(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), "unknown_topic")
+		res <- err
+	}()
+
+	hc.expect(t, EventSubscribe)
+	hc.send(t, `{"event":"error","code":10001,"description":"unknown topic","topic":"unknown_topic"}`)
+
+	var wsErr WebsocketError
+	if err := <-res; !errors.As(err, &wsErr) || wsErr.Code != ErrCodeUnknownTopic {
+		t.Fatalf("got error %v, want %v", err, ErrCodeUnknownTopic)
+	}
+}
+
+func TestSubscribeParameters(t *testing.T) {
+	hub := newFakeHub(t)
+	c, hc := hub.connect(t)
+
+	params := []Parameter{WithCRS("local"), WithProviderID("p1")}
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := c.Subscribe(context.Background(), TopicLocationUpdates, params...)
+		res <- err
+	}()
+
+	req := hc.expect(t, EventSubscribe)
+	if diff := cmp.Diff(Parameters{ParamCRS: "local", ParamProviderID: "p1"}, req.Params); diff != "" {
+		t.Errorf("params mismatch (-want +got):\n%s", diff)
+	}
+
+	hc.send(t, `{"event":"subscribed","topic":"location_updates","subscription_id":1}`)
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/wavecomtech/omlox-client-go"
 	"github.com/wavecomtech/omlox-client-go/internal/cli"
 
 	"github.com/spf13/cobra"
@@ -74,7 +73,7 @@ func newDeleteProvidersCmd(settings cli.EnvSettings, out io.Writer) *cobra.Comma
 
 // Provide dynamic auto-completion for trackable names.
 func compListProviders(toComplete string, ignoredProviderNames []string, settings cli.EnvSettings) ([]string, cobra.ShellCompDirective) {
-	c, err := omlox.New(settings.OmloxHubAPI)
+	c, err := newOmloxClient(&settings)
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveDefault
 	}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"io"
 
-	"github.com/wavecomtech/omlox-client-go"
 	"github.com/wavecomtech/omlox-client-go/internal/cli"
 
 	"github.com/google/uuid"
@@ -70,7 +69,7 @@ func newDeleteTrackablesCmd(settings cli.EnvSettings, out io.Writer) *cobra.Comm
 
 // Provide dynamic auto-completion for trackable names.
 func compListTrackables(toComplete string, ignoredTrackabeNames []string, settings cli.EnvSettings) ([]string, cobra.ShellCompDirective) {
-	c, err := omlox.New(settings.OmloxHubAPI)
+	c, err := newOmloxClient(&settings)
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveDefault
 	}
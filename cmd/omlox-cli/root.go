@@ -4,8 +4,10 @@
 package main
 
 import (
+	"crypto/tls"
 	"io"
 	"log/slog"
+	"net/http"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -26,9 +28,13 @@ Common actions for omlox client:
 
 Environment variables:
 
-| Name                 | Description                                                         |
-|----------------------|---------------------------------------------------------------------|
-| OMLOX_HUB_API        | Omlox hub API endpoint.                                             |
+| Name                       | Description                                                   |
+|----------------------------|---------------------------------------------------------------|
+| OMLOX_HUB_API              | Omlox hub API endpoint.                                       |
+| OMLOX_CA_CERT              | CA certificates file used to verify the hub.                  |
+| OMLOX_CLIENT_CERT          | Client certificate file for mutual TLS.                       |
+| OMLOX_CLIENT_KEY           | Client key file for mutual TLS.                               |
+| OMLOX_INSECURE_SKIP_VERIFY | Skip the verification of the hub certificate (insecure).      |
 `
 
 func newRootCmd(out io.Writer, args []string) (*cobra.Command, error) {
@@ -67,15 +73,41 @@ func newRootCmd(out io.Writer, args []string) (*cobra.Command, error) {
 func newOmloxClient(settings *cli.EnvSettings) (*omlox.Client, error) {
 	opts := make([]omlox.ClientOption, 0)
 
+	// replaces the TLS configuration, so it must come before the other TLS options
+	if settings.InsecureSkipVerify {
+		opts = append(opts, omlox.WithTLSConfig(&tls.Config{InsecureSkipVerify: true}))
+	}
+
+	if settings.CACert != "" {
+		opts = append(opts, omlox.WithCACertFile(settings.CACert))
+	}
+
+	if settings.ClientCert != "" || settings.ClientKey != "" {
+		opts = append(opts, omlox.WithClientCertificate(settings.ClientCert, settings.ClientKey))
+	}
+
 	if settings.Debug {
-		httpClient := omlox.DefaultConfiguration().HTTPClient
+		conf := omlox.DefaultConfiguration()
+		for _, opt := range opts {
+			if err := opt(&conf); err != nil {
+				return nil, err
+			}
+		}
+
+		// the TLS configuration is set on the transport before being wrapped by the logger
+		transport := conf.HTTPClient.Transport.(*http.Transport)
+		transport.TLSClientConfig = conf.TLSConfig
 
-		httpClient.Transport = &log.SlogerRoundTripper{
+		conf.HTTPClient.Transport = &log.SlogerRoundTripper{
 			Logger: slog.Default(),
-			Base:   httpClient.Transport,
+			Base:   transport,
 		}
 
-		opts = append(opts, omlox.WithHTTPClient(httpClient))
+		opts = []omlox.ClientOption{omlox.WithHTTPClient(conf.HTTPClient)}
+	}
+
+	if version != "" {
+		opts = append(opts, omlox.WithUserAgent(appName+"/"+version))
 	}
 
 	return omlox.New(settings.OmloxHubAPI, opts...)
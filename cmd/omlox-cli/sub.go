@@ -6,11 +6,14 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"github.com/wavecomtech/omlox-client-go"
 	"github.com/wavecomtech/omlox-client-go/internal/cli"
@@ -29,9 +32,19 @@ The Omlox standard supports a few:
 	- fence_events:geojson
 
 Extra topics can be supported by vendors.
+
+Subscription parameters can be passed with the repeatable --param flag:
+
+	omlox sub location_updates --param crs=EPSG:4326 --param provider_type=uwb
+
+Standard parameters (crs, zone_id, provider_id, provider_type, trackable_id
+and fence_id) are validated against the topic. Other parameters are passed
+to the hub as is.
 `
 
 func newSubCmd(settings cli.EnvSettings, out io.Writer) *cobra.Command {
+	var params []string
+
 	getCmd := &cobra.Command{
 		Use:     "subscribe",
 		Aliases: []string{"sub"},
@@ -56,8 +69,17 @@ func newSubCmd(settings cli.EnvSettings, out io.Writer) *cobra.Command {
 				return err
 			}
 
+			var opts []omlox.Parameter
+			for _, kv := range params {
+				p, err := parseParam(kv)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, p)
+			}
+
 			topic := omlox.Topic(args[0])
-			sub, err := c.Subscribe(ctx, topic)
+			sub, err := c.Subscribe(ctx, topic, opts...)
 			if err != nil {
 				return err
 			}
@@ -76,9 +98,44 @@ func newSubCmd(settings cli.EnvSettings, out io.Writer) *cobra.Command {
 		},
 	}
 
+	f := getCmd.Flags()
+	f.StringArrayVarP(&params, "param", "p", []string{}, "Subscription parameter in the key=value format (can be repeated)")
+
 	return getCmd
 }
 
+// parseParam parses a key=value subscription parameter.
+func parseParam(kv string) (omlox.Parameter, error) {
+	name, value, ok := strings.Cut(kv, "=")
+	if !ok {
+		return nil, fmt.Errorf("invalid parameter '%s': must be in the key=value format", kv)
+	}
+
+	switch name {
+	case omlox.ParamCRS:
+		return omlox.WithCRS(value), nil
+	case omlox.ParamProviderID:
+		return omlox.WithProviderID(value), nil
+	case omlox.ParamProviderType:
+		var t omlox.LocationProviderType
+		if err := t.FromString(value); err != nil {
+			return nil, err
+		}
+		return omlox.WithProviderType(t), nil
+	case omlox.ParamZoneID:
+		id, err := parseUUIDParam(name, value)
+		return omlox.WithZoneID(id), err
+	case omlox.ParamTrackableID:
+		id, err := parseUUIDParam(name, value)
+		return omlox.WithTrackableID(id), err
+	case omlox.ParamFenceID:
+		id, err := parseUUIDParam(name, value)
+		return omlox.WithFenceID(id), err
+	}
+
+	return omlox.WithParameter(name, value), nil
+}
+
 // Provide dynamic auto-completion for websockets topics.
 func compListTopics(toComplete string, ignoredProviderNames []string, settings cli.EnvSettings) ([]string, cobra.ShellCompDirective) {
 	return []string{
@@ -90,3 +147,11 @@ func compListTopics(toComplete string, ignoredProviderNames []string, settings c
 		string(omlox.TopicTrackableMotions),
 	}, cobra.ShellCompDirectiveNoFileComp
 }
+
+func parseUUIDParam(name, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s '%s': %w", name, value, err)
+	}
+	return id, nil
+}
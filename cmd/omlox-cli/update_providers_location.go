@@ -59,11 +59,23 @@ func newUpdateProvidersLocationsCmd(settings cli.EnvSettings, out io.Writer) *co
 				return err
 			}
 
-			for _, p := range loader.Resources {
-				err := c.Providers.UpdateLocation(context.Background(), p, p.ProviderID)
-				if err != nil {
+			// prefer the bulk update, falling back to one request
+			// per provider if the hub does not support it.
+			bulk := true
+			if err := c.Providers.UpdateLocations(context.Background(), loader.Resources); err != nil {
+				if !isUnsupportedEndpoint(err) {
 					return err
 				}
+				bulk = false
+			}
+
+			for _, p := range loader.Resources {
+				if !bulk {
+					err := c.Providers.UpdateLocation(context.Background(), p, p.ProviderID)
+					if err != nil {
+						return err
+					}
+				}
 
 				fmt.Fprintf(out, "updated: %v location\n", p.ProviderID)
 			}
@@ -0,0 +1,130 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wavecomtech/omlox-client-go/internal/cli"
+)
+
+const testProvidersLocations = `[
+	{"source":"fdb6df62-bce8-6c23-e342-80bd5c938774","provider_type":"uwb","provider_id":"77:4f:34:69:27:40","crs":"local","position":{"type":"Point","coordinates":[1,2]}},
+	{"source":"fdb6df62-bce8-6c23-e342-80bd5c938774","provider_type":"uwb","provider_id":"77:4f:34:69:27:41","crs":"local","position":{"type":"Point","coordinates":[3,4]}}
+]`
+
+func TestUpdateProvidersLocations(t *testing.T) {
+	cases := []struct {
+		name string
+
+		// response of the hub to the bulk update
+		status int
+		body   string
+
+		wantRequests []string
+		wantErr      bool
+	}{
+		{
+			name:         "bulk",
+			status:       http.StatusNoContent,
+			wantRequests: []string{"PUT /providers/locations"},
+		},
+		{
+			name:   "fallback-not-found",
+			status: http.StatusNotFound,
+			body:   "404 page not found",
+			wantRequests: []string{
+				"PUT /providers/locations",
+				"PUT /providers/77:4f:34:69:27:40/location",
+				"PUT /providers/77:4f:34:69:27:41/location",
+			},
+		},
+		{
+			name:   "fallback-method-not-allowed",
+			status: http.StatusMethodNotAllowed,
+			body:   `{"type":"method_not_allowed","message":"use PUT /providers/{id}/location"}`,
+			wantRequests: []string{
+				"PUT /providers/locations",
+				"PUT /providers/77:4f:34:69:27:40/location",
+				"PUT /providers/77:4f:34:69:27:41/location",
+			},
+		},
+		{
+			name:   "fallback-not-implemented",
+			status: http.StatusNotImplemented,
+			body:   `{"type":"not_implemented","code":501,"message":"bulk updates are not supported"}`,
+			wantRequests: []string{
+				"PUT /providers/locations",
+				"PUT /providers/77:4f:34:69:27:40/location",
+				"PUT /providers/77:4f:34:69:27:41/location",
+			},
+		},
+		{
+			name:         "bad-request",
+			status:       http.StatusBadRequest,
+			body:         `{"type":"bad_request","code":400,"message":"invalid location"}`,
+			wantRequests: []string{"PUT /providers/locations"},
+			wantErr:      true,
+		},
+		{
+			name:         "server-error",
+			status:       http.StatusInternalServerError,
+			body:         "internal server error",
+			wantRequests: []string{"PUT /providers/locations"},
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []string
+			)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path := r.URL.Path[strings.Index(r.URL.Path, "/providers"):]
+
+				mu.Lock()
+				requests = append(requests, r.Method+" "+path)
+				mu.Unlock()
+
+				if path != "/providers/locations" {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			var out bytes.Buffer
+			cmd := newUpdateProvidersLocationsCmd(cli.EnvSettings{OmloxHubAPI: srv.URL}, &out)
+			cmd.SetIn(strings.NewReader(testProvidersLocations))
+			cmd.SetArgs([]string{})
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tc.wantErr)
+			}
+
+			if diff := cmp.Diff(tc.wantRequests, requests); diff != "" {
+				t.Errorf("requests mismatch (-want +got):\n%s", diff)
+			}
+
+			if !tc.wantErr && strings.Count(out.String(), "updated:") != 2 {
+				t.Errorf("unexpected output:\n%s", out.String())
+			}
+		})
+	}
+}
@@ -3,6 +3,13 @@
 
 package main
 
+import (
+	"errors"
+	"net/http"
+
+	"github.com/wavecomtech/omlox-client-go"
+)
+
 // Returns all IDs from 'ids', except those with names matching 'ignoredIDs'
 func filterIDs(ids []string, ignoredIDs []string) []string {
 	if ignoredIDs == nil {
@@ -25,3 +32,24 @@ func filterIDs(ids []string, ignoredIDs []string) []string {
 
 	return filtered
 }
+
+// isUnsupportedEndpoint reports whether the error indicates that the hub does not implement the requested endpoint.
+// It is decided on the HTTP status of the response, whatever its body.
+func isUnsupportedEndpoint(err error) bool {
+	var e *omlox.Error
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	code := e.StatusCode
+	if code == 0 {
+		code = e.Code
+	}
+
+	switch code {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// maxPooledBuffer is the capacity above which buffers are not returned to the pool,
+// so that an occasional large message does not pin its memory.
+const maxPooledBuffer = 1 << 20
+
+// bufferPool recycles the buffers used to read and write messages.
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// getBuffer returns an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer returns the buffer to the pool. It must not be used afterwards.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+// wrapperPool recycles the wrapper objects written to the websocket connection.
+var wrapperPool = sync.Pool{
+	New: func() any { return new(WrapperObject) },
+}
+
+// getWrapper returns a copy of the wrapper object from the pool, with its own parameters.
+func getWrapper(wrObj *WrapperObject) *WrapperObject {
+	clone := wrapperPool.Get().(*WrapperObject)
+
+	params := clone.Params
+	if params == nil {
+		params = make(Parameters, len(wrObj.Params)+1)
+	}
+	for k, v := range wrObj.Params {
+		params[k] = v
+	}
+
+	*clone = *wrObj
+	clone.Params = params
+
+	return clone
+}
+
+// putWrapper returns the wrapper object to the pool. It must not be used afterwards.
+func putWrapper(wrObj *WrapperObject) {
+	params := wrObj.Params
+	clear(params)
+
+	*wrObj = WrapperObject{Params: params}
+	wrapperPool.Put(wrObj)
+}
+
+// marshalJSON encodes the value with its easyjson marshaler, if available.
+func marshalJSON(v any) ([]byte, error) {
+	if m, ok := v.(easyjson.Marshaler); ok {
+		return easyjson.Marshal(m)
+	}
+	return json.Marshal(v)
+}
+
+// writeJSON encodes the value into the buffer with its easyjson marshaler.
+func writeJSON(buf *bytes.Buffer, v easyjson.Marshaler) error {
+	// encode directly into the free capacity of the buffer, which is
+	// only reallocated by the writer if the value doesn't fit
+	var w jwriter.Writer
+	w.Buffer.Buf = buf.AvailableBuffer()
+
+	v.MarshalEasyJSON(&w)
+
+	data, err := w.BuildBytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = buf.Write(data)
+	return err
+}
+
+// unmarshalJSON decodes the data into v with its easyjson unmarshaler, if available.
+// The decoded value does not retain the data, so that it can be reused.
+func unmarshalJSON[T any](data []byte, v *T) error {
+	if u, ok := any(v).(easyjson.Unmarshaler); ok {
+		return easyjson.Unmarshal(data, u)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// unmarshalJSONList decodes a JSON array into a list of T with the easyjson unmarshaler of T, if available.
+func unmarshalJSONList[T any](data []byte) ([]T, error) {
+	var zero T
+	if _, ok := any(&zero).(easyjson.Unmarshaler); !ok {
+		var list []T
+		err := json.Unmarshal(data, &list)
+		return list, err
+	}
+
+	in := jlexer.Lexer{Data: data}
+
+	if in.IsNull() {
+		in.Skip()
+		in.Consumed()
+		return nil, in.Error()
+	}
+
+	list := make([]T, 0)
+
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		// decode in place, so that the element does not escape to the heap
+		list = append(list, zero)
+		any(&list[len(list)-1]).(easyjson.Unmarshaler).UnmarshalEasyJSON(&in)
+		in.WantComma()
+	}
+	in.Delim(']')
+	in.Consumed()
+
+	if err := in.Error(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// easyjsonList encodes a list of values with their easyjson marshalers.
+type easyjsonList[T easyjson.Marshaler] []T
+
+// MarshalEasyJSON implements easyjson.Marshaler.
+func (l easyjsonList[T]) MarshalEasyJSON(w *jwriter.Writer) {
+	if l == nil {
+		w.RawString("null")
+		return
+	}
+
+	w.RawByte('[')
+	for i, v := range l {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		v.MarshalEasyJSON(w)
+	}
+	w.RawByte(']')
+}
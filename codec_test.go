@@ -0,0 +1,277 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"nhooyr.io/websocket"
+)
+
+// testMessage is a location_updates message with a fully-populated location.
+var testMessage = []byte(`{"event":"message","topic":"location_updates","subscription_id":1,"payload":[` + string(locationJSONTestCases[1].json) + `]}`)
+
+// testLocationList returns a JSON array of n locations.
+func testLocationList(n int) []byte {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = string(locationJSONTestCases[1].json)
+	}
+	return []byte("[" + strings.Join(items, ",") + "]")
+}
+
+func TestUnmarshalJSONList(t *testing.T) {
+	t.Run("easyjson", func(t *testing.T) {
+		got, err := unmarshalJSONList[Location](testLocationList(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []Location{locationJSONTestCases[1].location, locationJSONTestCases[1].location, locationJSONTestCases[1].location}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unmarshalJSONList() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("encoding_json", func(t *testing.T) {
+		got, err := unmarshalJSONList[string]([]byte(`["a","b"]`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff([]string{"a", "b"}, got); diff != "" {
+			t.Errorf("unmarshalJSONList() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := unmarshalJSONList[Location]([]byte(`[]`))
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("got %v, %v, want an empty list", got, err)
+		}
+
+		got, err = unmarshalJSONList[Location]([]byte(`null`))
+		if err != nil || got != nil {
+			t.Errorf("got %v, %v, want a nil list", got, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, data := range []string{`{}`, `[{"position":"invalid"}]`, `[{}`, `[] []`} {
+			if _, err := unmarshalJSONList[Location]([]byte(data)); err == nil {
+				t.Errorf("%s: expected error", data)
+			}
+		}
+	})
+}
+
+func TestEasyjsonList(t *testing.T) {
+	locations := []Location{locationJSONTestCases[0].location, locationJSONTestCases[1].location}
+
+	got, err := marshalJSON(easyjsonList[Location](locations))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := json.Marshal(locations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if got, _ := marshalJSON(easyjsonList[Location](nil)); string(got) != "null" {
+		t.Errorf("got %s, want null", got)
+	}
+}
+
+func TestPooledWrapper(t *testing.T) {
+	wrObj := &WrapperObject{Event: EventMsg, Topic: TopicLocationUpdates, Params: Parameters{ParamCRS: "local"}}
+
+	clone := getWrapper(wrObj)
+	clone.Params[ParamAccessToken] = "secret"
+	putWrapper(clone)
+
+	// the original and reused wrapper objects do not share parameters
+	if _, ok := wrObj.Params[ParamAccessToken]; ok {
+		t.Error("access token set on the original wrapper object")
+	}
+
+	clone = getWrapper(&WrapperObject{Event: EventSubscribe})
+	defer putWrapper(clone)
+
+	if len(clone.Params) != 0 || clone.Topic != "" {
+		t.Errorf("reused wrapper object is not reset: %+v", clone)
+	}
+}
+
+func BenchmarkReadMessage(b *testing.B) {
+	b.Run("easyjson", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(testMessage)))
+
+		for i := 0; i < b.N; i++ {
+			if _, err := readMessage(bytes.NewReader(testMessage)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// the previous decoder, for comparison
+	b.Run("encoding_json", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(testMessage)))
+
+		for i := 0; i < b.N; i++ {
+			var wrObj struct {
+				WebsocketError
+				WrapperObject
+			}
+			if err := json.NewDecoder(bytes.NewReader(testMessage)).Decode(&wrObj); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkWriteMessage(b *testing.B) {
+	wrObj := &WrapperObject{
+		Event:   EventMsg,
+		Topic:   TopicLocationUpdates,
+		Payload: []json.RawMessage{locationJSONTestCases[1].json},
+	}
+
+	b.Run("easyjson", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			buf := getBuffer()
+			if err := writeJSON(buf, wrObj); err != nil {
+				b.Fatal(err)
+			}
+			putBuffer(buf)
+		}
+	})
+
+	// the previous encoder, for comparison
+	b.Run("encoding_json", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(wrObj); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkParseResponseList(b *testing.B) {
+	data := testLocationList(100)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parseResponseList[Location](bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkReceiveLocations measures the websocket receive path, from the hub
+// message to the decoded location, reporting the allocations per message.
+func BenchmarkReceiveLocations(b *testing.B) {
+	for _, overflow := range []OverflowStrategy{OverflowBlock, OverflowCoalesce} {
+		b.Run(overflow.String(), func(b *testing.B) {
+			benchmarkReceiveLocations(b, overflow)
+		})
+	}
+}
+
+func benchmarkReceiveLocations(b *testing.B, overflow OverflowStrategy) {
+	hub := newFakeHub(b)
+	c, hc := hub.connect(b, WithSubscriptionTimeout(0))
+
+	res := make(chan *TypedSubscription[Location], 1)
+	go func() {
+		sub, err := c.SubscribeLocationUpdates(context.Background(), WithOverflow(overflow))
+		if err != nil {
+			b.Error(err)
+		}
+		res <- sub
+	}()
+
+	hc.expect(b, EventSubscribe)
+	hc.send(b, `{"event":"subscribed","topic":"location_updates","subscription_id":1}`)
+
+	sub := <-res
+	if sub == nil {
+		b.FailNow()
+	}
+
+	// coalesced messages are never received, so the dropped ones are polled
+	poll := time.NewTicker(time.Millisecond)
+	defer poll.Stop()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	n := b.N
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+
+		ctx := context.Background()
+		for i := 0; i < n; i++ {
+			if err := hc.conn.Write(ctx, websocket.MessageText, testMessage); err != nil {
+				return
+			}
+		}
+	}()
+
+	for received := 0; received+int(sub.Dropped()) < n; {
+		select {
+		case <-sub.Receive():
+			received++
+		case <-poll.C:
+		}
+	}
+
+	<-written
+}
+
+func BenchmarkListLocations(b *testing.B) {
+	data := testLocationList(100)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	}))
+	b.Cleanup(srv.Close)
+
+	c, err := New(srv.URL)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Providers.Locations(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(b.N*100)/b.Elapsed().Seconds(), "locations/s")
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"time"
+)
+
+// CollisionEvent defines model for CollisionEvent.
+// A collision event is sent when trackables start to collide, continue to collide and end a collision.
+//
+//easyjson:json
+type CollisionEvent struct {
+	// Either 'collision_start', 'collision_ongoing' or 'collision_end'.
+	CollisionType EventType `json:"collision_type"`
+
+	// The colliding objects (at least two).
+	Collisions []Collision `json:"collisions"`
+
+	// The timestamp when the collision event was generated.
+	TimestampGenerated *time.Time `json:"timestamp_generated,omitempty"`
+}
+
+// Collision describes an object taking part in a collision.
+type Collision struct {
+	// The id of the colliding object (a trackable or location provider id).
+	ID string `json:"id"`
+
+	// Either 'trackable' or 'location_provider'.
+	ObjectType ObjectType `json:"object_type"`
+
+	// The position of the colliding object.
+	Position *Point `json:"position,omitempty"`
+
+	// The radius in meters of the colliding object.
+	Radius float64 `json:"radius,omitempty"`
+}
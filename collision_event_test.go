@@ -0,0 +1,66 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"testing"
+
+	"github.com/tidwall/geojson/geometry"
+)
+
+var collisionEventJSONTestCases = []struct {
+	name  string
+	event CollisionEvent
+	json  []byte
+}{
+	{
+		name: "required",
+		event: CollisionEvent{
+			CollisionType: EventTypeCollisionStart,
+			Collisions: []Collision{
+				{ID: "9b59961e-2a6a-4712-86e7-aba5a3e8be1f", ObjectType: ObjectTypeTrackable},
+				{ID: "77:4f:34:69:27:40", ObjectType: ObjectTypeLocationProvider},
+			},
+		},
+		json: []byte(`{"collision_type":"collision_start","collisions":[{"id":"9b59961e-2a6a-4712-86e7-aba5a3e8be1f","object_type":"trackable"},{"id":"77:4f:34:69:27:40","object_type":"location_provider"}]}`),
+	},
+	{
+		name: "fully-populated",
+		event: CollisionEvent{
+			CollisionType: EventTypeCollisionEnd,
+			Collisions: []Collision{
+				{
+					ID:         "9b59961e-2a6a-4712-86e7-aba5a3e8be1f",
+					ObjectType: ObjectTypeTrackable,
+					Position:   NewPoint(geometry.Point{X: 5, Y: 4}),
+					Radius:     1.5,
+				},
+				{
+					ID:         "a5865271-2e84-40d0-8f8f-e6f7ea15d103",
+					ObjectType: ObjectTypeTrackable,
+					Position:   NewPoint(geometry.Point{X: 6.2, Y: 4.1}),
+					Radius:     0.5,
+				},
+			},
+			TimestampGenerated: mustParseTime("2019-09-02T22:02:24.355Z"),
+		},
+		json: []byte(`{"collision_type":"collision_end","collisions":[{"id":"9b59961e-2a6a-4712-86e7-aba5a3e8be1f","object_type":"trackable","position":{"type":"Point","coordinates":[5,4]},"radius":1.5},{"id":"a5865271-2e84-40d0-8f8f-e6f7ea15d103","object_type":"trackable","position":{"type":"Point","coordinates":[6.2,4.1]},"radius":0.5}],"timestamp_generated":"2019-09-02T22:02:24.355Z"}`),
+	},
+}
+
+func TestCollisionEventMarshal(t *testing.T) {
+	for _, tc := range collisionEventJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONMarshalOK(t, tc.event, tc.json)
+		})
+	}
+}
+
+func TestCollisionEventUnmarshal(t *testing.T) {
+	for _, tc := range collisionEventJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONUnmarshalOK(t, tc.json, tc.event)
+		})
+	}
+}
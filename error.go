@@ -9,13 +9,25 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"log/slog"
 )
 
+// Errors matched by [Error] with errors.Is, according to its status code.
+var (
+	ErrBadRequest   = errors.New("bad request")  // 400 and 422
+	ErrUnauthorized = errors.New("unauthorized") // 401 and 403
+	ErrNotFound     = errors.New("not found")    // 404
+	ErrConflict     = errors.New("conflict")     // 409
+	ErrServer       = errors.New("server error") // 5xx
+)
+
 // Error is the error returned when Omlox Hub responds with an HTTP status
 // code outside of the 200 - 399 range.  If a request fails due to a
 // network error, a different error message will be returned.
+//
+// The kind of error can be checked with errors.Is (e.g. errors.Is(err, ErrNotFound)).
 type Error struct {
 	// A text representation of the error type (required).
 	Type string `json:"type"`
@@ -25,6 +37,16 @@ type Error struct {
 
 	// A human readable error message which may give a hint to what went wrong (optional).
 	Message string `json:"message"`
+
+	// Method and Path of the request that failed.
+	Method string `json:"-"`
+	Path   string `json:"-"`
+
+	// StatusCode of the HTTP response, which hubs may not report in the error body.
+	StatusCode int `json:"-"`
+
+	// Body is the raw response body.
+	Body []byte `json:"-"`
 }
 
 // isResponseError determines if this is a response error based on the response
@@ -44,16 +66,57 @@ func isResponseError(r *http.Response) error {
 	}
 
 	var responseError Error
-	if err := json.Unmarshal(responseBody, &responseError); err != nil {
-		// return the raw response body
-		return errors.New(string(responseBody))
+	if err := json.Unmarshal(responseBody, &responseError); err != nil || (responseError.Type == "" && responseError.Code == 0) {
+		// keep the raw response body as the message
+		responseError = Error{
+			Type:    strings.ToLower(http.StatusText(r.StatusCode)),
+			Code:    r.StatusCode,
+			Message: strings.TrimSpace(string(responseBody)),
+		}
+	}
+
+	responseError.StatusCode = r.StatusCode
+	responseError.Body = responseBody
+
+	if r.Request != nil {
+		responseError.Method = r.Request.Method
+		responseError.Path = r.Request.URL.Path
 	}
 
 	return &responseError
 }
 
 func (err Error) Error() string {
-	return fmt.Sprintf("%s (code %d): %s", err.Type, err.Code, err.Message)
+	msg := fmt.Sprintf("%s (code %d): %s", err.Type, err.Code, err.Message)
+
+	if err.Method != "" {
+		return err.Method + " " + err.Path + ": " + msg
+	}
+
+	return msg
+}
+
+// Is reports if the error is of the kind of the target sentinel error (e.g. [ErrNotFound]).
+func (err Error) Is(target error) bool {
+	code := err.StatusCode
+	if code == 0 {
+		code = err.Code
+	}
+
+	switch target {
+	case ErrBadRequest:
+		return code == http.StatusBadRequest || code == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return code == http.StatusUnauthorized || code == http.StatusForbidden
+	case ErrNotFound:
+		return code == http.StatusNotFound
+	case ErrConflict:
+		return code == http.StatusConflict
+	case ErrServer:
+		return code >= 500 && code <= 599
+	}
+
+	return false
 }
 
 // LogValue implements [slog.LogValuer] to convert itself into a Value for logging.
@@ -62,5 +125,7 @@ func (err Error) LogValue() slog.Value {
 		slog.String("type", err.Type),
 		slog.Int("code", err.Code),
 		slog.String("msg", err.Message),
+		slog.String("method", err.Method),
+		slog.String("path", err.Path),
 	)
 }
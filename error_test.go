@@ -4,7 +4,14 @@
 package omlox
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/google/uuid"
 )
 
 var errorsJSONTestCases = []struct {
@@ -32,3 +39,65 @@ func TestErrorUnmarshal(t *testing.T) {
 		JSONUnmarshalOK(t, tc.json, tc.err)
 	}
 }
+
+func TestResponseError(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		kind    error
+		message string
+	}{
+		{"bad_request", http.StatusBadRequest, `{"type":"bad request","code":400,"message":"invalid trackable"}`, ErrBadRequest, "invalid trackable"},
+		{"unprocessable", http.StatusUnprocessableEntity, `{"type":"validation","code":422,"message":"invalid zone"}`, ErrBadRequest, "invalid zone"},
+		{"unauthorized", http.StatusUnauthorized, `{"type":"unauthorized","code":401,"message":"missing token"}`, ErrUnauthorized, "missing token"},
+		{"forbidden", http.StatusForbidden, `forbidden`, ErrUnauthorized, "forbidden"},
+		{"not_found", http.StatusNotFound, `{"type":"not found","code":404,"message":"zone does not exist"}`, ErrNotFound, "zone does not exist"},
+		{"conflict", http.StatusConflict, `{"type":"conflict","code":409,"message":"zone already exists"}`, ErrConflict, "zone already exists"},
+		{"server", http.StatusBadGateway, "<html>bad gateway</html>\n", ErrServer, "<html>bad gateway</html>"},
+		{"empty", http.StatusInternalServerError, ``, ErrServer, ""},
+	}
+
+	kinds := []error{ErrBadRequest, ErrUnauthorized, ErrNotFound, ErrConflict, ErrServer}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			c, err := New(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.Zones.Get(context.Background(), uuid.Nil)
+
+			var e *Error
+			if !errors.As(err, &e) {
+				t.Fatalf("got error %T, want *Error", err)
+			}
+
+			for _, kind := range kinds {
+				if got := errors.Is(err, kind); got != (kind == tt.kind) {
+					t.Errorf("errors.Is(err, %v) = %v", kind, got)
+				}
+			}
+
+			if e.Message != tt.message {
+				t.Errorf("message = %q, want %q", e.Message, tt.message)
+			}
+			if e.StatusCode != tt.status || e.Code != tt.status {
+				t.Errorf("status = %d, code = %d, want %d", e.StatusCode, e.Code, tt.status)
+			}
+			if e.Method != http.MethodGet || e.Path != "/zones/"+uuid.Nil.String() {
+				t.Errorf("request = %s %s", e.Method, e.Path)
+			}
+			if string(e.Body) != tt.body {
+				t.Errorf("body = %q, want %q", e.Body, tt.body)
+			}
+		})
+	}
+}
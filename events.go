@@ -0,0 +1,126 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The type of a fence or collision event.
+type EventType int
+
+// Defines values for EventType.
+const (
+	EventTypeRegionEntry EventType = iota
+	EventTypeRegionExit
+	EventTypeCollisionStart
+	EventTypeCollisionOngoing
+	EventTypeCollisionEnd
+)
+
+// FromString assigs itself from type name.
+func (t *EventType) FromString(name string) error {
+	v, ok := map[string]EventType{
+		EventTypeRegionEntry.String():      EventTypeRegionEntry,
+		EventTypeRegionExit.String():       EventTypeRegionExit,
+		EventTypeCollisionStart.String():   EventTypeCollisionStart,
+		EventTypeCollisionOngoing.String(): EventTypeCollisionOngoing,
+		EventTypeCollisionEnd.String():     EventTypeCollisionEnd,
+	}[name]
+
+	if !ok {
+		return fmt.Errorf("event of type %s not supported", name)
+	}
+
+	*t = v
+	return nil
+}
+
+// String return a text representation.
+func (t EventType) String() string {
+	types := [...]string{
+		"region_entry",
+		"region_exit",
+		"collision_start",
+		"collision_ongoing",
+		"collision_end",
+	}
+
+	if len(types) <= int(t) {
+		return ""
+	}
+
+	return types[t]
+}
+
+// MarshalJSON encodes type in to JSON.
+func (t EventType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes type from JSON.
+func (t *EventType) UnmarshalJSON(b []byte) error {
+	var s string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	return t.FromString(s)
+}
+
+// The type of object which triggered a fence or collision event.
+type ObjectType int
+
+// Defines values for ObjectType.
+const (
+	ObjectTypeTrackable ObjectType = iota
+	ObjectTypeLocationProvider
+)
+
+// FromString assigs itself from type name.
+func (t *ObjectType) FromString(name string) error {
+	v, ok := map[string]ObjectType{
+		ObjectTypeTrackable.String():        ObjectTypeTrackable,
+		ObjectTypeLocationProvider.String(): ObjectTypeLocationProvider,
+	}[name]
+
+	if !ok {
+		return fmt.Errorf("object of type %s not supported", name)
+	}
+
+	*t = v
+	return nil
+}
+
+// String return a text representation.
+func (t ObjectType) String() string {
+	types := [...]string{
+		"trackable",
+		"location_provider",
+	}
+
+	if len(types) <= int(t) {
+		return ""
+	}
+
+	return types[t]
+}
+
+// MarshalJSON encodes type in to JSON.
+func (t ObjectType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes type from JSON.
+func (t *ObjectType) UnmarshalJSON(b []byte) error {
+	var s string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	return t.FromString(s)
+}
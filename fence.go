@@ -0,0 +1,123 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/geojson"
+)
+
+// Fence defines model for Fence.
+//
+//easyjson:json
+type Fence struct {
+	// Must be a UUID. When creating a fence, a unique id will be generated if it is not provided.
+	ID uuid.UUID `json:"id"`
+
+	// GeoJson Polygon or Point geometry. If a Point is given, the radius of the fence must be set.
+	// Important: The geometry MUST be interpreted according to the coordinate reference system (crs) of the fence.
+	Region Region `json:"region"`
+
+	// The radius of the fence in meters, when the region is a Point geometry.
+	// Must be a positive number.
+	Radius float64 `json:"radius,omitempty"`
+
+	// The extrusion to be applied to the geometry in meters.
+	// Must be a positive number.
+	Extrusion float64 `json:"extrusion,omitempty"`
+
+	// A logical and non-localized representation for a building floor. Floor 0 represents the floor designated as 'ground'.
+	// Negative numbers designate floors below the ground floor and positive to indicate floors above the ground floor.
+	Floor float64 `json:"floor,omitempty"`
+
+	// A projection identifier defining the projection of the fence region. The crs MUST be either a valid EPSG identifier
+	// (https://epsg.io) or 'local' if the region is provided as a relative coordinate of a zone.
+	// If the crs field is not present, 'local' MUST be assumed as the default.
+	Crs string `json:"crs,omitempty"`
+
+	// The id of the zone in which the fence is defined. Required when the crs is 'local'.
+	ZoneID *uuid.UUID `json:"zone_id,omitempty"`
+
+	// A unique identifier of the fence in an external system.
+	ForeignID string `json:"foreign_id,omitempty"`
+
+	// A describing name.
+	Name string `json:"name,omitempty"`
+
+	// The timeout in milliseconds after which a location should expire and trigger a fence exit event
+	// (if no more location updates are sent).
+	// Must be a positive number or -1 in case of an infinite timeout.
+	Timeout Duration `json:"timeout,omitempty"`
+
+	// The minimum distance in meters to release from an ongoing fence event.
+	// For example, for a location provider that was previously inside the fence, the fence exit event will not be
+	// generated until its distance to the fence geometry is at least the given exit_tolerance.
+	// Must be a positive number.
+	ExitTolerance float64 `json:"exit_tolerance,omitempty"`
+
+	// The timeout in milliseconds after which a location outside of the fence but still within exit_tolerance
+	// distance should release from the fence.
+	// Must be a positive number or -1 in case of an infinite timeout.
+	ToleranceTimeout Duration `json:"tolerance_timeout,omitempty"`
+
+	// The delay in milliseconds in which an imminent exit event should wait for another location update.
+	// This is relevant for fast rate position updates with quick moving objects.
+	// The provided number must be positive or -1 in case of an infinite exit_delay.
+	ExitDelay Duration `json:"exit_delay,omitempty"`
+
+	// Any additional application or vendor specific properties.
+	// An application implementing this object is not required to interpret any of the custom properties,
+	// but it MUST preserve the properties if set.
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
+
+// Region is the GeoJson geometry of a fence.
+// Only one of Polygon or Point must be set.
+type Region struct {
+	Polygon *Polygon
+	Point   *Point
+}
+
+// NewPolygonRegion returns a fence region defined by a polygon.
+func NewPolygonRegion(poly *Polygon) Region {
+	return Region{Polygon: poly}
+}
+
+// NewPointRegion returns a fence region defined by a point.
+// The fence radius should be set accordingly.
+func NewPointRegion(point *Point) Region {
+	return Region{Point: point}
+}
+
+func (r Region) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Polygon != nil:
+		return r.Polygon.MarshalJSON()
+	case r.Point != nil:
+		return r.Point.MarshalJSON()
+	}
+
+	return []byte("null"), nil
+}
+
+func (r *Region) UnmarshalJSON(data []byte) error {
+	o, err := geojson.Parse(string(data), geojson.DefaultParseOptions)
+	if err != nil {
+		return err
+	}
+
+	switch g := o.(type) {
+	case *geojson.Polygon:
+		*r = Region{Polygon: &Polygon{Polygon: *g}}
+	case *geojson.Point:
+		*r = Region{Point: &Point{Point: *g}}
+	default:
+		return errors.New("not a valid geojson polygon or point")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FenceEvent defines model for FenceEvent.
+// A fence event informs about a trackable or location provider entering or exiting a fence.
+//
+//easyjson:json
+type FenceEvent struct {
+	// The id of the fence event.
+	ID uuid.UUID `json:"id"`
+
+	// The id of the fence which triggered the event.
+	FenceID uuid.UUID `json:"fence_id"`
+
+	// The foreign id of the fence which triggered the event (if set in the fence).
+	ForeignID string `json:"foreign_id,omitempty"`
+
+	// The id of the location provider which triggered the event.
+	ProviderID string `json:"provider_id,omitempty"`
+
+	// The id of the trackable which triggered the event (if the object type is a trackable).
+	TrackableID *uuid.UUID `json:"trackable_id,omitempty"`
+
+	// The timestamp when the fence was entered.
+	EntryTime *time.Time `json:"entry_time,omitempty"`
+
+	// The timestamp when the fence was exited.
+	ExitTime *time.Time `json:"exit_time,omitempty"`
+
+	// Either 'region_entry' or 'region_exit'.
+	EventType EventType `json:"event_type"`
+
+	// Either 'trackable' or 'location_provider'.
+	ObjectType ObjectType `json:"object_type"`
+
+	// The location which triggered the event.
+	Location *Location `json:"location,omitempty"`
+
+	// Any additional application or vendor specific properties.
+	// An application implementing this object is not required to interpret any of the custom properties,
+	// but it MUST preserve the properties if set.
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/geojson/geometry"
+)
+
+var fenceEventJSONTestCases = []struct {
+	name  string
+	event FenceEvent
+	json  []byte
+}{
+	{
+		name: "required",
+		event: FenceEvent{
+			ID:         uuid.MustParse("c3e0f1a2-6c35-4b55-9b40-55d3e8b7d2f1"),
+			FenceID:    uuid.MustParse("2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9"),
+			EventType:  EventTypeRegionEntry,
+			ObjectType: ObjectTypeLocationProvider,
+		},
+		json: []byte(`{"id":"c3e0f1a2-6c35-4b55-9b40-55d3e8b7d2f1","fence_id":"2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9","event_type":"region_entry","object_type":"location_provider"}`),
+	},
+	{
+		name: "fully-populated",
+		event: FenceEvent{
+			ID:          uuid.MustParse("c3e0f1a2-6c35-4b55-9b40-55d3e8b7d2f1"),
+			FenceID:     uuid.MustParse("2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9"),
+			ForeignID:   "dock-03",
+			ProviderID:  "77:4f:34:69:27:40",
+			TrackableID: opt(uuid.MustParse("9b59961e-2a6a-4712-86e7-aba5a3e8be1f")),
+			EntryTime:   mustParseTime("2019-09-02T22:01:10.124Z"),
+			ExitTime:    mustParseTime("2019-09-02T22:02:24.355Z"),
+			EventType:   EventTypeRegionExit,
+			ObjectType:  ObjectTypeTrackable,
+			Location: &Location{
+				Position:     *NewPoint(geometry.Point{X: 5, Y: 4}),
+				Source:       "fdb6df62-bce8-6c23-e342-80bd5c938774",
+				ProviderType: LocationProviderTypeUwb,
+				ProviderID:   "77:4f:34:69:27:40",
+			},
+			Properties: json.RawMessage(`{"org.wavecom.whereis":{"alarm":true}}`),
+		},
+		json: []byte(`{"id":"c3e0f1a2-6c35-4b55-9b40-55d3e8b7d2f1","fence_id":"2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9","foreign_id":"dock-03","provider_id":"77:4f:34:69:27:40","trackable_id":"9b59961e-2a6a-4712-86e7-aba5a3e8be1f","entry_time":"2019-09-02T22:01:10.124Z","exit_time":"2019-09-02T22:02:24.355Z","event_type":"region_exit","object_type":"trackable","location":{"position":{"type":"Point","coordinates":[5,4]},"source":"fdb6df62-bce8-6c23-e342-80bd5c938774","provider_type":"uwb","provider_id":"77:4f:34:69:27:40"},"properties":{"org.wavecom.whereis":{"alarm":true}}}`),
+	},
+}
+
+func TestFenceEventMarshal(t *testing.T) {
+	for _, tc := range fenceEventJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONMarshalOK(t, tc.event, tc.json)
+		})
+	}
+}
+
+func TestFenceEventUnmarshal(t *testing.T) {
+	for _, tc := range fenceEventJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONUnmarshalOK(t, tc.json, tc.event)
+		})
+	}
+}
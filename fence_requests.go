@@ -0,0 +1,134 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// FencesAPI is a simple wrapper around the client for fence requests.
+type FencesAPI struct {
+	client *Client
+}
+
+// List lists all fences.
+func (c *FencesAPI) List(ctx context.Context, opts ...RequestOption) ([]Fence, error) {
+	requestPath := "/fences/summary"
+
+	return sendRequestParseResponseList[Fence](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// IDs lists all fence IDs.
+func (c *FencesAPI) IDs(ctx context.Context, opts ...RequestOption) ([]uuid.UUID, error) {
+	requestPath := "/fences"
+
+	return sendRequestParseResponseList[uuid.UUID](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Create creates a fence.
+func (c *FencesAPI) Create(ctx context.Context, fence Fence, opts ...RequestOption) (*Fence, error) {
+	requestPath := "/fences"
+
+	return sendStructuredRequestParseResponse[Fence](
+		ctx,
+		c.client,
+		http.MethodPost,
+		requestPath,
+		fence,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// DeleteAll deletes all fences.
+func (c *FencesAPI) DeleteAll(ctx context.Context, opts ...RequestOption) error {
+	requestPath := "/fences"
+
+	_, err := sendRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodDelete,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Get gets a fence.
+func (c *FencesAPI) Get(ctx context.Context, id uuid.UUID, opts ...RequestOption) (*Fence, error) {
+	requestPath := "/fences/" + id.String()
+
+	return sendRequestParseResponse[Fence](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Delete deletes a fence.
+func (c *FencesAPI) Delete(ctx context.Context, id uuid.UUID, opts ...RequestOption) error {
+	requestPath := "/fences/" + id.String()
+
+	_, err := sendRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodDelete,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Update updates a fence.
+func (c *FencesAPI) Update(ctx context.Context, fence Fence, id uuid.UUID, opts ...RequestOption) error {
+	requestPath := "/fences/" + id.String()
+
+	_, err := sendStructuredRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodPut,
+		requestPath,
+		fence,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
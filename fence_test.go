@@ -0,0 +1,69 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/geojson/geometry"
+)
+
+var fencesJSONTestCases = []struct {
+	name  string
+	fence Fence
+	json  []byte
+}{
+	{
+		name: "required",
+		fence: Fence{
+			ID: uuid.MustParse("2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9"),
+			Region: NewPolygonRegion(NewPolygon(geometry.NewPoly([]geometry.Point{
+				{X: 0, Y: 0},
+				{X: 10, Y: 0},
+				{X: 10, Y: 5},
+				{X: 0, Y: 5},
+				{X: 0, Y: 0},
+			}, nil, geometry.DefaultIndexOptions))),
+		},
+		json: []byte(`{"id":"2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9","region":{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,5],[0,5],[0,0]]]}}`),
+	},
+	{
+		name: "fully-populated",
+		fence: Fence{
+			ID:               uuid.MustParse("2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9"),
+			Region:           NewPointRegion(NewPoint(geometry.Point{X: 12.5, Y: 4.25})),
+			Radius:           2.5,
+			Extrusion:        3,
+			Floor:            1,
+			Crs:              "local",
+			ZoneID:           opt(uuid.MustParse("f4c05a2b-afd3-41a0-88e2-46f69bdb192e")),
+			ForeignID:        "dock-03",
+			Name:             "Loading Dock",
+			Timeout:          NewDuration(5000),
+			ExitTolerance:    0.5,
+			ToleranceTimeout: NewDuration(Inf),
+			ExitDelay:        NewDuration(200),
+			Properties:       json.RawMessage(`{"org.wavecom.whereis":{"alarm":true}}`),
+		},
+		json: []byte(`{"id":"2a4b5ef1-8cb2-4cb4-9f44-2e30a8f1e5a9","region":{"type":"Point","coordinates":[12.5,4.25]},"radius":2.5,"extrusion":3,"floor":1,"crs":"local","zone_id":"f4c05a2b-afd3-41a0-88e2-46f69bdb192e","foreign_id":"dock-03","name":"Loading Dock","timeout":5000,"exit_tolerance":0.5,"tolerance_timeout":-1,"exit_delay":200,"properties":{"org.wavecom.whereis":{"alarm":true}}}`),
+	},
+}
+
+func TestFenceMarshal(t *testing.T) {
+	for _, tc := range fencesJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONMarshalOK(t, tc.fence, tc.json)
+		})
+	}
+}
+
+func TestFenceUnmarshal(t *testing.T) {
+	for _, tc := range fencesJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONUnmarshalOK(t, tc.json, tc.fence)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/spf13/pflag"
 )
@@ -19,12 +20,27 @@ type EnvSettings struct {
 
 	// Debug indicates whether or not the Omlox Client is running in Debug mode.
 	Debug bool
+
+	// CACert is the path of the CA certificates file used to verify the hub.
+	CACert string
+
+	// ClientCert and ClientKey are the paths of the client certificate and key files
+	// used to authenticate to the hub (mutual TLS).
+	ClientCert string
+	ClientKey  string
+
+	// InsecureSkipVerify disables the verification of the hub certificate.
+	InsecureSkipVerify bool
 }
 
 // New creates a new environment settings loading the environment variables.
 func New() *EnvSettings {
 	env := &EnvSettings{
-		OmloxHubAPI: envOr("OMLOX_HUB_API", DefaultOmloxHubAPI),
+		OmloxHubAPI:        envOr("OMLOX_HUB_API", DefaultOmloxHubAPI),
+		CACert:             envOr("OMLOX_CA_CERT", ""),
+		ClientCert:         envOr("OMLOX_CLIENT_CERT", ""),
+		ClientKey:          envOr("OMLOX_CLIENT_KEY", ""),
+		InsecureSkipVerify: envBoolOr("OMLOX_INSECURE_SKIP_VERIFY", false),
 	}
 
 	return env
@@ -33,6 +49,10 @@ func New() *EnvSettings {
 func (s *EnvSettings) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.OmloxHubAPI, "addr", s.OmloxHubAPI, "omlox hub API endpoint")
 	fs.BoolVar(&s.Debug, "debug", s.Debug, "enable debug logging")
+	fs.StringVar(&s.CACert, "ca-cert", s.CACert, "verify the hub certificate with the CA certificates of this file")
+	fs.StringVar(&s.ClientCert, "client-cert", s.ClientCert, "client certificate file for mutual TLS")
+	fs.StringVar(&s.ClientKey, "client-key", s.ClientKey, "client key file for mutual TLS")
+	fs.BoolVar(&s.InsecureSkipVerify, "insecure-skip-verify", s.InsecureSkipVerify, "skip the verification of the hub certificate (insecure)")
 }
 
 func envOr(name, def string) string {
@@ -41,3 +61,12 @@ func envOr(name, def string) string {
 	}
 	return def
 }
+
+func envBoolOr(name string, def bool) bool {
+	if v, ok := os.LookupEnv(name); ok {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return def
+}
@@ -5,7 +5,9 @@ package omlox
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -97,6 +99,60 @@ type Location struct {
 	Properties json.RawMessage `json:"properties,omitempty"`
 }
 
+// Well known coordinate reference systems (crs) of locations.
+const (
+	// Location coordinates relative to the local coordinate system of a zone.
+	CrsLocal = "local"
+
+	// WGS84 geographic coordinates (longitude, latitude), as used by GPS.
+	CrsWGS84 = "EPSG:4326"
+)
+
+// Errors
+var (
+	ErrInvalidLocation = errors.New("invalid location")
+)
+
+// Validate checks that the location has the required fields and valid values,
+// as required by the hub to accept location updates.
+func (l Location) Validate() error {
+	switch {
+	case l.Source == "":
+		return fmt.Errorf("%w: empty source", ErrInvalidLocation)
+	case l.ProviderID == "":
+		return fmt.Errorf("%w: empty provider_id", ErrInvalidLocation)
+	case l.ProviderType.String() == "":
+		return fmt.Errorf("%w: unknown provider_type %d", ErrInvalidLocation, l.ProviderType)
+	case l.Crs != "" && !crsPattern.MatchString(l.Crs):
+		return fmt.Errorf("%w: crs '%s' must be 'local' or an EPSG identifier", ErrInvalidLocation, l.Crs)
+	case l.Accuracy != nil && *l.Accuracy < 0:
+		return fmt.Errorf("%w: negative accuracy", ErrInvalidLocation)
+	case l.Speed != nil && *l.Speed < 0:
+		return fmt.Errorf("%w: negative speed", ErrInvalidLocation)
+	}
+
+	p := l.Position.Base()
+	for _, v := range []float64{p.X, p.Y, l.Position.Z()} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("%w: position coordinates must be finite", ErrInvalidLocation)
+		}
+	}
+
+	return nil
+}
+
+// Transform converts the location position between the local coordinate system of the given zone and WGS84,
+// using the transformation computed from the zone ground control points.
+// When transforming many locations of the same zone, compute the [ZoneTransform] once with [Zone.Transform] instead.
+func (l Location) Transform(zone Zone) (Location, error) {
+	t, err := zone.Transform()
+	if err != nil {
+		return l, err
+	}
+
+	return t.TransformLocation(l)
+}
+
 // An elevation reference hint for the position's z component. Must be either 'floor' or 'wgs84'.
 type ElevationRefType int
 
@@ -19,7 +19,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(in *jlexer.Lexer, out *WrapperObject) {
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(in *jlexer.Lexer, out *wrapperObject) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -91,6 +91,10 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(in *jlexer.Lexer, o
 				}
 				in.Delim('}')
 			}
+		case "code":
+			out.Code = ErrCode(in.Int())
+		case "description":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -101,7 +105,7 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(out *jwriter.Writer, in WrapperObject) {
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(out *jwriter.Writer, in wrapperObject) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -153,112 +157,43 @@ func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(out *jwriter.Writer
 			out.RawByte('}')
 		}
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v WrapperObject) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v WrapperObject) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *WrapperObject) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *WrapperObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(l, v)
-}
-func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo1(in *jlexer.Lexer, out *WebsocketError) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "code":
-			out.Code = ErrCode(in.Int())
-		case "description":
-			out.Description = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo1(out *jwriter.Writer, in WebsocketError) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	if in.Code != 0 {
 		const prefix string = ",\"code\":"
-		first = false
-		out.RawString(prefix[1:])
+		out.RawString(prefix)
 		out.Int(int(in.Code))
 	}
 	if in.Description != "" {
 		const prefix string = ",\"description\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
+		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v WebsocketError) MarshalJSON() ([]byte, error) {
+func (v wrapperObject) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo1(&w, v)
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v WebsocketError) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo1(w, v)
+func (v wrapperObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *WebsocketError) UnmarshalJSON(data []byte) error {
+func (v *wrapperObject) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo1(&r, v)
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *WebsocketError) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo1(l, v)
+func (v *wrapperObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo(l, v)
 }
-func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(in *jlexer.Lexer, out *Trackable) {
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo1(in *jlexer.Lexer, out *Zone) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -285,81 +220,68 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(in *jlexer.Lexer,
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Type).UnmarshalJSON(data))
 			}
+		case "foreign_id":
+			out.ForeignID = string(in.String())
 		case "name":
 			out.Name = string(in.String())
-		case "geometry":
+		case "description":
+			out.Description = string(in.String())
+		case "position":
 			if in.IsNull() {
 				in.Skip()
-				out.Geometry = nil
+				out.Position = nil
 			} else {
-				if out.Geometry == nil {
-					out.Geometry = new(Polygon)
+				if out.Position == nil {
+					out.Position = new(Point)
 				}
 				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.Geometry).UnmarshalJSON(data))
+					in.AddError((*out.Position).UnmarshalJSON(data))
 				}
 			}
-		case "extrusion":
-			out.Extrusion = float64(in.Float64())
-		case "location_providers":
+		case "radius":
+			out.Radius = float64(in.Float64())
+		case "ground_control_points":
 			if in.IsNull() {
 				in.Skip()
-				out.LocationProviders = nil
+				out.GroundControlPoints = nil
 			} else {
 				in.Delim('[')
-				if out.LocationProviders == nil {
+				if out.GroundControlPoints == nil {
 					if !in.IsDelim(']') {
-						out.LocationProviders = make([]string, 0, 4)
+						out.GroundControlPoints = make([]GroundControlPoint, 0, 1)
 					} else {
-						out.LocationProviders = []string{}
+						out.GroundControlPoints = []GroundControlPoint{}
 					}
 				} else {
-					out.LocationProviders = (out.LocationProviders)[:0]
+					out.GroundControlPoints = (out.GroundControlPoints)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v6 string
-					v6 = string(in.String())
-					out.LocationProviders = append(out.LocationProviders, v6)
+					var v6 GroundControlPoint
+					easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(in, &v6)
+					out.GroundControlPoints = append(out.GroundControlPoints, v6)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "fence_timeout":
-			(out.FenceTimeout).UnmarshalEasyJSON(in)
-		case "exit_tolerance":
-			out.ExitTolerance = float64(in.Float64())
-		case "tolerance_timeout":
-			(out.ToleranceTimeout).UnmarshalEasyJSON(in)
-		case "exit_delay":
-			(out.ExitDelay).UnmarshalEasyJSON(in)
-		case "radius":
-			out.Radius = float64(in.Float64())
-		case "properties":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Properties).UnmarshalJSON(data))
-			}
-		case "locating_rules":
+		case "incomplete_configuration":
+			out.IncompleteConfiguration = bool(in.Bool())
+		case "measurement_timestamp":
 			if in.IsNull() {
 				in.Skip()
-				out.LocatingRules = nil
+				out.MeasurementTimestamp = nil
 			} else {
-				in.Delim('[')
-				if out.LocatingRules == nil {
-					if !in.IsDelim(']') {
-						out.LocatingRules = make([]LocatingRule, 0, 2)
-					} else {
-						out.LocatingRules = []LocatingRule{}
-					}
-				} else {
-					out.LocatingRules = (out.LocatingRules)[:0]
+				if out.MeasurementTimestamp == nil {
+					out.MeasurementTimestamp = new(time.Time)
 				}
-				for !in.IsDelim(']') {
-					var v7 LocatingRule
-					easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(in, &v7)
-					out.LocatingRules = append(out.LocatingRules, v7)
-					in.WantComma()
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MeasurementTimestamp).UnmarshalJSON(data))
 				}
-				in.Delim(']')
+			}
+		case "floor":
+			out.Floor = float64(in.Float64())
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -371,7 +293,7 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo2(out *jwriter.Writer, in Trackable) {
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo1(out *jwriter.Writer, in Zone) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -385,106 +307,92 @@ func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo2(out *jwriter.Write
 		out.RawString(prefix)
 		out.Raw((in.Type).MarshalJSON())
 	}
+	if in.ForeignID != "" {
+		const prefix string = ",\"foreign_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ForeignID))
+	}
 	if in.Name != "" {
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
-	if in.Geometry != nil {
-		const prefix string = ",\"geometry\":"
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.Raw((*in.Geometry).MarshalJSON())
+		out.String(string(in.Description))
 	}
-	if in.Extrusion != 0 {
-		const prefix string = ",\"extrusion\":"
+	if in.Position != nil {
+		const prefix string = ",\"position\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Extrusion))
+		out.Raw((*in.Position).MarshalJSON())
 	}
-	if len(in.LocationProviders) != 0 {
-		const prefix string = ",\"location_providers\":"
+	if in.Radius != 0 {
+		const prefix string = ",\"radius\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Radius))
+	}
+	if len(in.GroundControlPoints) != 0 {
+		const prefix string = ",\"ground_control_points\":"
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v8, v9 := range in.LocationProviders {
-				if v8 > 0 {
+			for v7, v8 := range in.GroundControlPoints {
+				if v7 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v9))
+				easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo2(out, v8)
 			}
 			out.RawByte(']')
 		}
 	}
-	if (in.FenceTimeout).IsDefined() {
-		const prefix string = ",\"fence_timeout\":"
-		out.RawString(prefix)
-		(in.FenceTimeout).MarshalEasyJSON(out)
-	}
-	if in.ExitTolerance != 0 {
-		const prefix string = ",\"exit_tolerance\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.ExitTolerance))
-	}
-	if (in.ToleranceTimeout).IsDefined() {
-		const prefix string = ",\"tolerance_timeout\":"
+	if in.IncompleteConfiguration {
+		const prefix string = ",\"incomplete_configuration\":"
 		out.RawString(prefix)
-		(in.ToleranceTimeout).MarshalEasyJSON(out)
+		out.Bool(bool(in.IncompleteConfiguration))
 	}
-	if (in.ExitDelay).IsDefined() {
-		const prefix string = ",\"exit_delay\":"
+	if in.MeasurementTimestamp != nil {
+		const prefix string = ",\"measurement_timestamp\":"
 		out.RawString(prefix)
-		(in.ExitDelay).MarshalEasyJSON(out)
+		out.Raw((*in.MeasurementTimestamp).MarshalJSON())
 	}
-	if in.Radius != 0 {
-		const prefix string = ",\"radius\":"
+	if in.Floor != 0 {
+		const prefix string = ",\"floor\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Radius))
+		out.Float64(float64(in.Floor))
 	}
 	if len(in.Properties) != 0 {
 		const prefix string = ",\"properties\":"
 		out.RawString(prefix)
 		out.Raw((in.Properties).MarshalJSON())
 	}
-	if len(in.LocatingRules) != 0 {
-		const prefix string = ",\"locating_rules\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v10, v11 := range in.LocatingRules {
-				if v10 > 0 {
-					out.RawByte(',')
-				}
-				easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo3(out, v11)
-			}
-			out.RawByte(']')
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Trackable) MarshalJSON() ([]byte, error) {
+func (v Zone) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo2(&w, v)
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Trackable) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo2(w, v)
+func (v Zone) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Trackable) UnmarshalJSON(data []byte) error {
+func (v *Zone) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(&r, v)
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Trackable) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(l, v)
+func (v *Zone) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo1(l, v)
 }
-func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(in *jlexer.Lexer, out *LocatingRule) {
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo2(in *jlexer.Lexer, out *GroundControlPoint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -503,10 +411,14 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "expression":
-			out.Expression = string(in.String())
-		case "priority":
-			out.Priority = int(in.Int())
+		case "wgs84":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Wgs84).UnmarshalJSON(data))
+			}
+		case "local":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Local).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -517,23 +429,23 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo3(out *jwriter.Writer, in LocatingRule) {
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo2(out *jwriter.Writer, in GroundControlPoint) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"expression\":"
+		const prefix string = ",\"wgs84\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Expression))
+		out.Raw((in.Wgs84).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"priority\":"
+		const prefix string = ",\"local\":"
 		out.RawString(prefix)
-		out.Int(int(in.Priority))
+		out.Raw((in.Local).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo4(in *jlexer.Lexer, out *LocationProvider) {
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(in *jlexer.Lexer, out *WrapperObject) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -552,33 +464,58 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo4(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "type":
+		case "event":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Type).UnmarshalJSON(data))
+				in.AddError((out.Event).UnmarshalJSON(data))
 			}
-		case "name":
-			out.Name = string(in.String())
-		case "sensors":
-			if m, ok := out.Sensors.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Sensors.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "topic":
+			out.Topic = Topic(in.String())
+		case "subscription_id":
+			out.SubscriptionID = int(in.Int())
+		case "payload":
+			if in.IsNull() {
+				in.Skip()
+				out.Payload = nil
 			} else {
-				out.Sensors = in.Interface()
+				in.Delim('[')
+				if out.Payload == nil {
+					if !in.IsDelim(']') {
+						out.Payload = make([]json.RawMessage, 0, 2)
+					} else {
+						out.Payload = []json.RawMessage{}
+					}
+				} else {
+					out.Payload = (out.Payload)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 json.RawMessage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v9).UnmarshalJSON(data))
+					}
+					out.Payload = append(out.Payload, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "fence_timeout":
-			(out.FenceTimeout).UnmarshalEasyJSON(in)
-		case "exit_tolerance":
-			out.ExitTolerance = float64(in.Float64())
-		case "tolerance_timeout":
-			(out.ToleranceTimeout).UnmarshalEasyJSON(in)
-		case "exit_delay":
-			(out.ExitDelay).UnmarshalEasyJSON(in)
-		case "properties":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Properties).UnmarshalJSON(data))
+		case "params":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Params = make(Parameters)
+				} else {
+					out.Params = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v10 string
+					v10 = string(in.String())
+					(out.Params)[key] = v10
+					in.WantComma()
+				}
+				in.Delim('}')
 			}
 		default:
 			in.SkipRecursive()
@@ -590,88 +527,164 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo4(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo4(out *jwriter.Writer, in LocationProvider) {
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo3(out *jwriter.Writer, in WrapperObject) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"event\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		out.String(string(in.Event))
 	}
-	{
-		const prefix string = ",\"type\":"
+	if in.Topic != "" {
+		const prefix string = ",\"topic\":"
 		out.RawString(prefix)
-		out.Raw((in.Type).MarshalJSON())
+		out.String(string(in.Topic))
 	}
-	if in.Name != "" {
-		const prefix string = ",\"name\":"
+	if in.SubscriptionID != 0 {
+		const prefix string = ",\"subscription_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Int(int(in.SubscriptionID))
 	}
-	if in.Sensors != nil {
-		const prefix string = ",\"sensors\":"
+	if len(in.Payload) != 0 {
+		const prefix string = ",\"payload\":"
 		out.RawString(prefix)
-		if m, ok := in.Sensors.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Sensors.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Sensors))
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Payload {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v12).MarshalJSON())
+			}
+			out.RawByte(']')
 		}
 	}
-	if (in.FenceTimeout).IsDefined() {
-		const prefix string = ",\"fence_timeout\":"
+	if len(in.Params) != 0 {
+		const prefix string = ",\"params\":"
 		out.RawString(prefix)
-		(in.FenceTimeout).MarshalEasyJSON(out)
+		{
+			out.RawByte('{')
+			v13First := true
+			for v13Name, v13Value := range in.Params {
+				if v13First {
+					v13First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v13Name))
+				out.RawByte(':')
+				out.String(string(v13Value))
+			}
+			out.RawByte('}')
+		}
 	}
-	if in.ExitTolerance != 0 {
-		const prefix string = ",\"exit_tolerance\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.ExitTolerance))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WrapperObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WrapperObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WrapperObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WrapperObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo3(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo4(in *jlexer.Lexer, out *WebsocketError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	if (in.ToleranceTimeout).IsDefined() {
-		const prefix string = ",\"tolerance_timeout\":"
-		out.RawString(prefix)
-		(in.ToleranceTimeout).MarshalEasyJSON(out)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = ErrCode(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	if (in.ExitDelay).IsDefined() {
-		const prefix string = ",\"exit_delay\":"
-		out.RawString(prefix)
-		(in.ExitDelay).MarshalEasyJSON(out)
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
-	if len(in.Properties) != 0 {
-		const prefix string = ",\"properties\":"
-		out.RawString(prefix)
-		out.Raw((in.Properties).MarshalJSON())
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo4(out *jwriter.Writer, in WebsocketError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Code != 0 {
+		const prefix string = ",\"code\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Code))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LocationProvider) MarshalJSON() ([]byte, error) {
+func (v WebsocketError) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LocationProvider) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WebsocketError) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LocationProvider) UnmarshalJSON(data []byte) error {
+func (v *WebsocketError) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LocationProvider) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WebsocketError) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo4(l, v)
 }
-func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(in *jlexer.Lexer, out *Location) {
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(in *jlexer.Lexer, out *TrackableMotion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -690,145 +703,1192 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "position":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Position).UnmarshalJSON(data))
-			}
-		case "source":
-			out.Source = string(in.String())
-		case "provider_type":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ProviderType).UnmarshalJSON(data))
-			}
-		case "provider_id":
-			out.ProviderID = string(in.String())
-		case "trackables":
-			if in.IsNull() {
-				in.Skip()
-				out.Trackables = nil
-			} else {
-				in.Delim('[')
-				if out.Trackables == nil {
-					if !in.IsDelim(']') {
-						out.Trackables = make([]uuid.UUID, 0, 4)
-					} else {
-						out.Trackables = []uuid.UUID{}
-					}
-				} else {
-					out.Trackables = (out.Trackables)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v12 uuid.UUID
-					if data := in.UnsafeBytes(); in.Ok() {
-						in.AddError((v12).UnmarshalText(data))
-					}
-					out.Trackables = append(out.Trackables, v12)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "timestamp_generated":
-			if in.IsNull() {
-				in.Skip()
-				out.TimestampGenerated = nil
-			} else {
-				if out.TimestampGenerated == nil {
-					out.TimestampGenerated = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.TimestampGenerated).UnmarshalJSON(data))
-				}
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
 			}
-		case "timestamp_sent":
+		case "location":
+			(out.Location).UnmarshalEasyJSON(in)
+		case "name":
+			out.Name = string(in.String())
+		case "geometry":
 			if in.IsNull() {
 				in.Skip()
-				out.TimestampSent = nil
+				out.Geometry = nil
 			} else {
-				if out.TimestampSent == nil {
-					out.TimestampSent = new(time.Time)
+				if out.Geometry == nil {
+					out.Geometry = new(Polygon)
 				}
 				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.TimestampSent).UnmarshalJSON(data))
-				}
-			}
-		case "crs":
-			out.Crs = string(in.String())
-		case "associated":
-			out.Associated = bool(in.Bool())
-		case "accuracy":
-			if in.IsNull() {
-				in.Skip()
-				out.Accuracy = nil
-			} else {
-				if out.Accuracy == nil {
-					out.Accuracy = new(float64)
+					in.AddError((*out.Geometry).UnmarshalJSON(data))
 				}
-				*out.Accuracy = float64(in.Float64())
 			}
-		case "floor":
-			out.Floor = float64(in.Float64())
-		case "true_heading":
-			if in.IsNull() {
-				in.Skip()
-				out.TrueHeading = nil
-			} else {
-				if out.TrueHeading == nil {
-					out.TrueHeading = new(float64)
-				}
-				*out.TrueHeading = float64(in.Float64())
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
 			}
-		case "magnetic_heading":
-			if in.IsNull() {
-				in.Skip()
-				out.MagneticHeading = nil
-			} else {
-				if out.MagneticHeading == nil {
-					out.MagneticHeading = new(float64)
-				}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo5(out *jwriter.Writer, in TrackableMotion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		(in.Location).MarshalEasyJSON(out)
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Geometry != nil {
+		const prefix string = ",\"geometry\":"
+		out.RawString(prefix)
+		out.Raw((*in.Geometry).MarshalJSON())
+	}
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		out.Raw((in.Properties).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrackableMotion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrackableMotion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrackableMotion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrackableMotion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo6(in *jlexer.Lexer, out *Trackable) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Type).UnmarshalJSON(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "geometry":
+			if in.IsNull() {
+				in.Skip()
+				out.Geometry = nil
+			} else {
+				if out.Geometry == nil {
+					out.Geometry = new(Polygon)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Geometry).UnmarshalJSON(data))
+				}
+			}
+		case "extrusion":
+			out.Extrusion = float64(in.Float64())
+		case "location_providers":
+			if in.IsNull() {
+				in.Skip()
+				out.LocationProviders = nil
+			} else {
+				in.Delim('[')
+				if out.LocationProviders == nil {
+					if !in.IsDelim(']') {
+						out.LocationProviders = make([]string, 0, 4)
+					} else {
+						out.LocationProviders = []string{}
+					}
+				} else {
+					out.LocationProviders = (out.LocationProviders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 string
+					v14 = string(in.String())
+					out.LocationProviders = append(out.LocationProviders, v14)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "fence_timeout":
+			(out.FenceTimeout).UnmarshalEasyJSON(in)
+		case "exit_tolerance":
+			out.ExitTolerance = float64(in.Float64())
+		case "tolerance_timeout":
+			(out.ToleranceTimeout).UnmarshalEasyJSON(in)
+		case "exit_delay":
+			(out.ExitDelay).UnmarshalEasyJSON(in)
+		case "radius":
+			out.Radius = float64(in.Float64())
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
+			}
+		case "locating_rules":
+			if in.IsNull() {
+				in.Skip()
+				out.LocatingRules = nil
+			} else {
+				in.Delim('[')
+				if out.LocatingRules == nil {
+					if !in.IsDelim(']') {
+						out.LocatingRules = make([]LocatingRule, 0, 2)
+					} else {
+						out.LocatingRules = []LocatingRule{}
+					}
+				} else {
+					out.LocatingRules = (out.LocatingRules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 LocatingRule
+					easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo7(in, &v15)
+					out.LocatingRules = append(out.LocatingRules, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo6(out *jwriter.Writer, in Trackable) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Raw((in.Type).MarshalJSON())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Geometry != nil {
+		const prefix string = ",\"geometry\":"
+		out.RawString(prefix)
+		out.Raw((*in.Geometry).MarshalJSON())
+	}
+	if in.Extrusion != 0 {
+		const prefix string = ",\"extrusion\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Extrusion))
+	}
+	if len(in.LocationProviders) != 0 {
+		const prefix string = ",\"location_providers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v16, v17 := range in.LocationProviders {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v17))
+			}
+			out.RawByte(']')
+		}
+	}
+	if (in.FenceTimeout).IsDefined() {
+		const prefix string = ",\"fence_timeout\":"
+		out.RawString(prefix)
+		(in.FenceTimeout).MarshalEasyJSON(out)
+	}
+	if in.ExitTolerance != 0 {
+		const prefix string = ",\"exit_tolerance\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ExitTolerance))
+	}
+	if (in.ToleranceTimeout).IsDefined() {
+		const prefix string = ",\"tolerance_timeout\":"
+		out.RawString(prefix)
+		(in.ToleranceTimeout).MarshalEasyJSON(out)
+	}
+	if (in.ExitDelay).IsDefined() {
+		const prefix string = ",\"exit_delay\":"
+		out.RawString(prefix)
+		(in.ExitDelay).MarshalEasyJSON(out)
+	}
+	if in.Radius != 0 {
+		const prefix string = ",\"radius\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Radius))
+	}
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		out.Raw((in.Properties).MarshalJSON())
+	}
+	if len(in.LocatingRules) != 0 {
+		const prefix string = ",\"locating_rules\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v18, v19 := range in.LocatingRules {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo7(out, v19)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Trackable) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Trackable) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Trackable) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Trackable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo6(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo7(in *jlexer.Lexer, out *LocatingRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "expression":
+			out.Expression = string(in.String())
+		case "priority":
+			out.Priority = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo7(out *jwriter.Writer, in LocatingRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"expression\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Expression))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.Int(int(in.Priority))
+	}
+	out.RawByte('}')
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo8(in *jlexer.Lexer, out *Proximity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "source":
+			out.Source = string(in.String())
+		case "provider_type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ProviderType).UnmarshalJSON(data))
+			}
+		case "provider_id":
+			out.ProviderID = string(in.String())
+		case "timestamp_generated":
+			if in.IsNull() {
+				in.Skip()
+				out.TimestampGenerated = nil
+			} else {
+				if out.TimestampGenerated == nil {
+					out.TimestampGenerated = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TimestampGenerated).UnmarshalJSON(data))
+				}
+			}
+		case "timestamp_sent":
+			if in.IsNull() {
+				in.Skip()
+				out.TimestampSent = nil
+			} else {
+				if out.TimestampSent == nil {
+					out.TimestampSent = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TimestampSent).UnmarshalJSON(data))
+				}
+			}
+		case "accuracy":
+			if in.IsNull() {
+				in.Skip()
+				out.Accuracy = nil
+			} else {
+				if out.Accuracy == nil {
+					out.Accuracy = new(float64)
+				}
+				*out.Accuracy = float64(in.Float64())
+			}
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo8(out *jwriter.Writer, in Proximity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"provider_type\":"
+		out.RawString(prefix)
+		out.Raw((in.ProviderType).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"provider_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ProviderID))
+	}
+	if in.TimestampGenerated != nil {
+		const prefix string = ",\"timestamp_generated\":"
+		out.RawString(prefix)
+		out.Raw((*in.TimestampGenerated).MarshalJSON())
+	}
+	if in.TimestampSent != nil {
+		const prefix string = ",\"timestamp_sent\":"
+		out.RawString(prefix)
+		out.Raw((*in.TimestampSent).MarshalJSON())
+	}
+	if in.Accuracy != nil {
+		const prefix string = ",\"accuracy\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Accuracy))
+	}
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		out.Raw((in.Properties).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Proximity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Proximity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Proximity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Proximity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo8(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo9(in *jlexer.Lexer, out *LocationProvider) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Type).UnmarshalJSON(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "sensors":
+			if m, ok := out.Sensors.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Sensors.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Sensors = in.Interface()
+			}
+		case "fence_timeout":
+			(out.FenceTimeout).UnmarshalEasyJSON(in)
+		case "exit_tolerance":
+			out.ExitTolerance = float64(in.Float64())
+		case "tolerance_timeout":
+			(out.ToleranceTimeout).UnmarshalEasyJSON(in)
+		case "exit_delay":
+			(out.ExitDelay).UnmarshalEasyJSON(in)
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo9(out *jwriter.Writer, in LocationProvider) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Raw((in.Type).MarshalJSON())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Sensors != nil {
+		const prefix string = ",\"sensors\":"
+		out.RawString(prefix)
+		if m, ok := in.Sensors.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Sensors.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Sensors))
+		}
+	}
+	if (in.FenceTimeout).IsDefined() {
+		const prefix string = ",\"fence_timeout\":"
+		out.RawString(prefix)
+		(in.FenceTimeout).MarshalEasyJSON(out)
+	}
+	if in.ExitTolerance != 0 {
+		const prefix string = ",\"exit_tolerance\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ExitTolerance))
+	}
+	if (in.ToleranceTimeout).IsDefined() {
+		const prefix string = ",\"tolerance_timeout\":"
+		out.RawString(prefix)
+		(in.ToleranceTimeout).MarshalEasyJSON(out)
+	}
+	if (in.ExitDelay).IsDefined() {
+		const prefix string = ",\"exit_delay\":"
+		out.RawString(prefix)
+		(in.ExitDelay).MarshalEasyJSON(out)
+	}
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		out.Raw((in.Properties).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LocationProvider) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LocationProvider) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LocationProvider) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LocationProvider) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo9(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo10(in *jlexer.Lexer, out *Location) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Position).UnmarshalJSON(data))
+			}
+		case "source":
+			out.Source = string(in.String())
+		case "provider_type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ProviderType).UnmarshalJSON(data))
+			}
+		case "provider_id":
+			out.ProviderID = string(in.String())
+		case "trackables":
+			if in.IsNull() {
+				in.Skip()
+				out.Trackables = nil
+			} else {
+				in.Delim('[')
+				if out.Trackables == nil {
+					if !in.IsDelim(']') {
+						out.Trackables = make([]uuid.UUID, 0, 4)
+					} else {
+						out.Trackables = []uuid.UUID{}
+					}
+				} else {
+					out.Trackables = (out.Trackables)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v20).UnmarshalText(data))
+					}
+					out.Trackables = append(out.Trackables, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "timestamp_generated":
+			if in.IsNull() {
+				in.Skip()
+				out.TimestampGenerated = nil
+			} else {
+				if out.TimestampGenerated == nil {
+					out.TimestampGenerated = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TimestampGenerated).UnmarshalJSON(data))
+				}
+			}
+		case "timestamp_sent":
+			if in.IsNull() {
+				in.Skip()
+				out.TimestampSent = nil
+			} else {
+				if out.TimestampSent == nil {
+					out.TimestampSent = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TimestampSent).UnmarshalJSON(data))
+				}
+			}
+		case "crs":
+			out.Crs = string(in.String())
+		case "associated":
+			out.Associated = bool(in.Bool())
+		case "accuracy":
+			if in.IsNull() {
+				in.Skip()
+				out.Accuracy = nil
+			} else {
+				if out.Accuracy == nil {
+					out.Accuracy = new(float64)
+				}
+				*out.Accuracy = float64(in.Float64())
+			}
+		case "floor":
+			out.Floor = float64(in.Float64())
+		case "true_heading":
+			if in.IsNull() {
+				in.Skip()
+				out.TrueHeading = nil
+			} else {
+				if out.TrueHeading == nil {
+					out.TrueHeading = new(float64)
+				}
+				*out.TrueHeading = float64(in.Float64())
+			}
+		case "magnetic_heading":
+			if in.IsNull() {
+				in.Skip()
+				out.MagneticHeading = nil
+			} else {
+				if out.MagneticHeading == nil {
+					out.MagneticHeading = new(float64)
+				}
 				*out.MagneticHeading = float64(in.Float64())
 			}
-		case "heading_accuracy":
+		case "heading_accuracy":
+			if in.IsNull() {
+				in.Skip()
+				out.HeadingAccuracy = nil
+			} else {
+				if out.HeadingAccuracy == nil {
+					out.HeadingAccuracy = new(float64)
+				}
+				*out.HeadingAccuracy = float64(in.Float64())
+			}
+		case "elevation_ref":
+			if in.IsNull() {
+				in.Skip()
+				out.ElevationRef = nil
+			} else {
+				if out.ElevationRef == nil {
+					out.ElevationRef = new(ElevationRefType)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ElevationRef).UnmarshalJSON(data))
+				}
+			}
+		case "speed":
+			if in.IsNull() {
+				in.Skip()
+				out.Speed = nil
+			} else {
+				if out.Speed == nil {
+					out.Speed = new(float64)
+				}
+				*out.Speed = float64(in.Float64())
+			}
+		case "course":
+			if in.IsNull() {
+				in.Skip()
+				out.Course = nil
+			} else {
+				if out.Course == nil {
+					out.Course = new(float64)
+				}
+				*out.Course = float64(in.Float64())
+			}
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo10(out *jwriter.Writer, in Location) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Position).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"provider_type\":"
+		out.RawString(prefix)
+		out.Raw((in.ProviderType).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"provider_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ProviderID))
+	}
+	if len(in.Trackables) != 0 {
+		const prefix string = ",\"trackables\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v21, v22 := range in.Trackables {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v22).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.TimestampGenerated != nil {
+		const prefix string = ",\"timestamp_generated\":"
+		out.RawString(prefix)
+		out.Raw((*in.TimestampGenerated).MarshalJSON())
+	}
+	if in.TimestampSent != nil {
+		const prefix string = ",\"timestamp_sent\":"
+		out.RawString(prefix)
+		out.Raw((*in.TimestampSent).MarshalJSON())
+	}
+	if in.Crs != "" {
+		const prefix string = ",\"crs\":"
+		out.RawString(prefix)
+		out.String(string(in.Crs))
+	}
+	if in.Associated {
+		const prefix string = ",\"associated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Associated))
+	}
+	if in.Accuracy != nil {
+		const prefix string = ",\"accuracy\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Accuracy))
+	}
+	if in.Floor != 0 {
+		const prefix string = ",\"floor\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Floor))
+	}
+	if in.TrueHeading != nil {
+		const prefix string = ",\"true_heading\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.TrueHeading))
+	}
+	if in.MagneticHeading != nil {
+		const prefix string = ",\"magnetic_heading\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.MagneticHeading))
+	}
+	if in.HeadingAccuracy != nil {
+		const prefix string = ",\"heading_accuracy\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.HeadingAccuracy))
+	}
+	if in.ElevationRef != nil {
+		const prefix string = ",\"elevation_ref\":"
+		out.RawString(prefix)
+		out.Raw((*in.ElevationRef).MarshalJSON())
+	}
+	if in.Speed != nil {
+		const prefix string = ",\"speed\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Speed))
+	}
+	if in.Course != nil {
+		const prefix string = ",\"course\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Course))
+	}
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		out.Raw((in.Properties).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Location) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Location) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Location) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Location) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo10(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo11(in *jlexer.Lexer, out *FenceEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "fence_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.FenceID).UnmarshalText(data))
+			}
+		case "foreign_id":
+			out.ForeignID = string(in.String())
+		case "provider_id":
+			out.ProviderID = string(in.String())
+		case "trackable_id":
 			if in.IsNull() {
 				in.Skip()
-				out.HeadingAccuracy = nil
+				out.TrackableID = nil
 			} else {
-				if out.HeadingAccuracy == nil {
-					out.HeadingAccuracy = new(float64)
+				if out.TrackableID == nil {
+					out.TrackableID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.TrackableID).UnmarshalText(data))
 				}
-				*out.HeadingAccuracy = float64(in.Float64())
 			}
-		case "elevation_ref":
+		case "entry_time":
 			if in.IsNull() {
 				in.Skip()
-				out.ElevationRef = nil
+				out.EntryTime = nil
 			} else {
-				if out.ElevationRef == nil {
-					out.ElevationRef = new(ElevationRefType)
+				if out.EntryTime == nil {
+					out.EntryTime = new(time.Time)
 				}
 				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.ElevationRef).UnmarshalJSON(data))
+					in.AddError((*out.EntryTime).UnmarshalJSON(data))
 				}
 			}
-		case "speed":
+		case "exit_time":
 			if in.IsNull() {
 				in.Skip()
-				out.Speed = nil
+				out.ExitTime = nil
 			} else {
-				if out.Speed == nil {
-					out.Speed = new(float64)
+				if out.ExitTime == nil {
+					out.ExitTime = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExitTime).UnmarshalJSON(data))
 				}
-				*out.Speed = float64(in.Float64())
 			}
-		case "course":
+		case "event_type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EventType).UnmarshalJSON(data))
+			}
+		case "object_type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ObjectType).UnmarshalJSON(data))
+			}
+		case "location":
 			if in.IsNull() {
 				in.Skip()
-				out.Course = nil
+				out.Location = nil
 			} else {
-				if out.Course == nil {
-					out.Course = new(float64)
+				if out.Location == nil {
+					out.Location = new(Location)
+				}
+				(*out.Location).UnmarshalEasyJSON(in)
+			}
+		case "properties":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Properties).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo11(out *jwriter.Writer, in FenceEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"fence_id\":"
+		out.RawString(prefix)
+		out.RawText((in.FenceID).MarshalText())
+	}
+	if in.ForeignID != "" {
+		const prefix string = ",\"foreign_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ForeignID))
+	}
+	if in.ProviderID != "" {
+		const prefix string = ",\"provider_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ProviderID))
+	}
+	if in.TrackableID != nil {
+		const prefix string = ",\"trackable_id\":"
+		out.RawString(prefix)
+		out.RawText((*in.TrackableID).MarshalText())
+	}
+	if in.EntryTime != nil {
+		const prefix string = ",\"entry_time\":"
+		out.RawString(prefix)
+		out.Raw((*in.EntryTime).MarshalJSON())
+	}
+	if in.ExitTime != nil {
+		const prefix string = ",\"exit_time\":"
+		out.RawString(prefix)
+		out.Raw((*in.ExitTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"event_type\":"
+		out.RawString(prefix)
+		out.Raw((in.EventType).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"object_type\":"
+		out.RawString(prefix)
+		out.Raw((in.ObjectType).MarshalJSON())
+	}
+	if in.Location != nil {
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		(*in.Location).MarshalEasyJSON(out)
+	}
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		out.Raw((in.Properties).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FenceEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FenceEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FenceEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FenceEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo11(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo12(in *jlexer.Lexer, out *Fence) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "radius":
+			out.Radius = float64(in.Float64())
+		case "extrusion":
+			out.Extrusion = float64(in.Float64())
+		case "floor":
+			out.Floor = float64(in.Float64())
+		case "crs":
+			out.Crs = string(in.String())
+		case "zone_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ZoneID = nil
+			} else {
+				if out.ZoneID == nil {
+					out.ZoneID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ZoneID).UnmarshalText(data))
 				}
-				*out.Course = float64(in.Float64())
 			}
+		case "foreign_id":
+			out.ForeignID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "timeout":
+			(out.Timeout).UnmarshalEasyJSON(in)
+		case "exit_tolerance":
+			out.ExitTolerance = float64(in.Float64())
+		case "tolerance_timeout":
+			(out.ToleranceTimeout).UnmarshalEasyJSON(in)
+		case "exit_delay":
+			(out.ExitDelay).UnmarshalEasyJSON(in)
 		case "properties":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Properties).UnmarshalJSON(data))
@@ -843,132 +1903,302 @@ func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo5(out *jwriter.Writer, in Location) {
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo12(out *jwriter.Writer, in Fence) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"position\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.Position).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"source\":"
-		out.RawString(prefix)
-		out.String(string(in.Source))
-	}
-	{
-		const prefix string = ",\"provider_type\":"
-		out.RawString(prefix)
-		out.Raw((in.ProviderType).MarshalJSON())
+		out.RawText((in.ID).MarshalText())
 	}
 	{
-		const prefix string = ",\"provider_id\":"
+		const prefix string = ",\"region\":"
 		out.RawString(prefix)
-		out.String(string(in.ProviderID))
+		out.Raw((in.Region).MarshalJSON())
 	}
-	if len(in.Trackables) != 0 {
-		const prefix string = ",\"trackables\":"
+	if in.Radius != 0 {
+		const prefix string = ",\"radius\":"
 		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v13, v14 := range in.Trackables {
-				if v13 > 0 {
-					out.RawByte(',')
-				}
-				out.RawText((v14).MarshalText())
-			}
-			out.RawByte(']')
-		}
+		out.Float64(float64(in.Radius))
 	}
-	if in.TimestampGenerated != nil {
-		const prefix string = ",\"timestamp_generated\":"
+	if in.Extrusion != 0 {
+		const prefix string = ",\"extrusion\":"
 		out.RawString(prefix)
-		out.Raw((*in.TimestampGenerated).MarshalJSON())
+		out.Float64(float64(in.Extrusion))
 	}
-	if in.TimestampSent != nil {
-		const prefix string = ",\"timestamp_sent\":"
+	if in.Floor != 0 {
+		const prefix string = ",\"floor\":"
 		out.RawString(prefix)
-		out.Raw((*in.TimestampSent).MarshalJSON())
+		out.Float64(float64(in.Floor))
 	}
 	if in.Crs != "" {
 		const prefix string = ",\"crs\":"
 		out.RawString(prefix)
 		out.String(string(in.Crs))
 	}
-	if in.Associated {
-		const prefix string = ",\"associated\":"
+	if in.ZoneID != nil {
+		const prefix string = ",\"zone_id\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Associated))
+		out.RawText((*in.ZoneID).MarshalText())
 	}
-	if in.Accuracy != nil {
-		const prefix string = ",\"accuracy\":"
+	if in.ForeignID != "" {
+		const prefix string = ",\"foreign_id\":"
 		out.RawString(prefix)
-		out.Float64(float64(*in.Accuracy))
+		out.String(string(in.ForeignID))
 	}
-	if in.Floor != 0 {
-		const prefix string = ",\"floor\":"
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Floor))
+		out.String(string(in.Name))
 	}
-	if in.TrueHeading != nil {
-		const prefix string = ",\"true_heading\":"
+	if (in.Timeout).IsDefined() {
+		const prefix string = ",\"timeout\":"
 		out.RawString(prefix)
-		out.Float64(float64(*in.TrueHeading))
+		(in.Timeout).MarshalEasyJSON(out)
 	}
-	if in.MagneticHeading != nil {
-		const prefix string = ",\"magnetic_heading\":"
+	if in.ExitTolerance != 0 {
+		const prefix string = ",\"exit_tolerance\":"
 		out.RawString(prefix)
-		out.Float64(float64(*in.MagneticHeading))
+		out.Float64(float64(in.ExitTolerance))
 	}
-	if in.HeadingAccuracy != nil {
-		const prefix string = ",\"heading_accuracy\":"
+	if (in.ToleranceTimeout).IsDefined() {
+		const prefix string = ",\"tolerance_timeout\":"
 		out.RawString(prefix)
-		out.Float64(float64(*in.HeadingAccuracy))
+		(in.ToleranceTimeout).MarshalEasyJSON(out)
 	}
-	if in.ElevationRef != nil {
-		const prefix string = ",\"elevation_ref\":"
+	if (in.ExitDelay).IsDefined() {
+		const prefix string = ",\"exit_delay\":"
 		out.RawString(prefix)
-		out.Raw((*in.ElevationRef).MarshalJSON())
+		(in.ExitDelay).MarshalEasyJSON(out)
 	}
-	if in.Speed != nil {
-		const prefix string = ",\"speed\":"
+	if len(in.Properties) != 0 {
+		const prefix string = ",\"properties\":"
 		out.RawString(prefix)
-		out.Float64(float64(*in.Speed))
+		out.Raw((in.Properties).MarshalJSON())
 	}
-	if in.Course != nil {
-		const prefix string = ",\"course\":"
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Fence) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Fence) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Fence) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Fence) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo12(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo13(in *jlexer.Lexer, out *CollisionEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "collision_type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CollisionType).UnmarshalJSON(data))
+			}
+		case "collisions":
+			if in.IsNull() {
+				in.Skip()
+				out.Collisions = nil
+			} else {
+				in.Delim('[')
+				if out.Collisions == nil {
+					if !in.IsDelim(']') {
+						out.Collisions = make([]Collision, 0, 1)
+					} else {
+						out.Collisions = []Collision{}
+					}
+				} else {
+					out.Collisions = (out.Collisions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 Collision
+					easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo14(in, &v23)
+					out.Collisions = append(out.Collisions, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "timestamp_generated":
+			if in.IsNull() {
+				in.Skip()
+				out.TimestampGenerated = nil
+			} else {
+				if out.TimestampGenerated == nil {
+					out.TimestampGenerated = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TimestampGenerated).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo13(out *jwriter.Writer, in CollisionEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"collision_type\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.CollisionType).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"collisions\":"
 		out.RawString(prefix)
-		out.Float64(float64(*in.Course))
+		if in.Collisions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Collisions {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo14(out, v25)
+			}
+			out.RawByte(']')
+		}
 	}
-	if len(in.Properties) != 0 {
-		const prefix string = ",\"properties\":"
+	if in.TimestampGenerated != nil {
+		const prefix string = ",\"timestamp_generated\":"
 		out.RawString(prefix)
-		out.Raw((in.Properties).MarshalJSON())
+		out.Raw((*in.TimestampGenerated).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Location) MarshalJSON() ([]byte, error) {
+func (v CollisionEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo5(&w, v)
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Location) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo5(w, v)
+func (v CollisionEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Location) UnmarshalJSON(data []byte) error {
+func (v *CollisionEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(&r, v)
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Location) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo5(l, v)
+func (v *CollisionEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo13(l, v)
+}
+func easyjsonF70c4027DecodeGithubComWavecomtechOmloxClientGo14(in *jlexer.Lexer, out *Collision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "object_type":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ObjectType).UnmarshalJSON(data))
+			}
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(Point)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Position).UnmarshalJSON(data))
+				}
+			}
+		case "radius":
+			out.Radius = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF70c4027EncodeGithubComWavecomtechOmloxClientGo14(out *jwriter.Writer, in Collision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"object_type\":"
+		out.RawString(prefix)
+		out.Raw((in.ObjectType).MarshalJSON())
+	}
+	if in.Position != nil {
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Raw((*in.Position).MarshalJSON())
+	}
+	if in.Radius != 0 {
+		const prefix string = ",\"radius\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Radius))
+	}
+	out.RawByte('}')
 }
@@ -47,3 +47,26 @@ func ExampleConnect() {
 		_ = location // handle location update
 	}
 }
+
+func ExampleClient_SubscribeLocationUpdates() {
+	// Dials a Omlox Hub websocket interface and listens to
+	// location updates decoded into omlox.Location objects.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := omlox.Connect(ctx, "localhost:7081/v2")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	sub, err := client.SubscribeLocationUpdates(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for location := range sub.Receive() {
+		_ = location // handle location update
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Subscription parameter names supported by the Omlox Hub websocket topics.
+const (
+	ParamCRS          = "crs"
+	ParamZoneID       = "zone_id"
+	ParamProviderID   = "provider_id"
+	ParamProviderType = "provider_type"
+	ParamTrackableID  = "trackable_id"
+	ParamFenceID      = "fence_id"
+
+	// ParamAccessToken carries the access token of the client in subscriptions
+	// and published messages. It is set by the client (see [WithTokenSource]).
+	ParamAccessToken = "access_token"
+)
+
+// Errors
+var (
+	ErrUnsupportedParameter = errors.New("unsupported parameter")
+	ErrInvalidParameter     = errors.New("invalid parameter")
+)
+
+// topicParameters maps each standard topic to the parameters it supports.
+var topicParameters = map[Topic][]string{
+	TopicLocationUpdates:        {ParamCRS, ParamZoneID, ParamProviderID, ParamProviderType, ParamTrackableID},
+	TopicLocationUpdatesGeoJSON: {ParamCRS, ParamZoneID, ParamProviderID, ParamProviderType, ParamTrackableID},
+	TopicFenceEvents:            {ParamFenceID, ParamProviderID, ParamTrackableID},
+	TopicFenceEventsGeoJSON:     {ParamFenceID, ParamProviderID, ParamTrackableID},
+	TopicCollisionEvents:        {ParamCRS, ParamProviderID, ParamTrackableID},
+	TopicTrackableMotions:       {ParamCRS, ParamTrackableID},
+}
+
+// crsPattern matches the 'local' crs or a EPSG identifier (e.g. EPSG:4326).
+var crsPattern = regexp.MustCompile(`^(local|EPSG:[0-9]+)$`)
+
+// WithCRS sets the coordinate reference system in which the hub should send locations.
+// The crs must be either 'local' or a valid EPSG identifier (e.g. EPSG:4326).
+func WithCRS(crs string) Parameter {
+	return func(topic Topic, params Parameters) error {
+		if !crsPattern.MatchString(crs) {
+			return fmt.Errorf("%w: crs '%s' must be 'local' or an EPSG identifier", ErrInvalidParameter, crs)
+		}
+		return setParameter(topic, params, ParamCRS, crs)
+	}
+}
+
+// WithZoneID filters the subscription data by zone.
+func WithZoneID(id uuid.UUID) Parameter {
+	return func(topic Topic, params Parameters) error {
+		return setParameter(topic, params, ParamZoneID, id.String())
+	}
+}
+
+// WithProviderID filters the subscription data by location provider.
+func WithProviderID(id string) Parameter {
+	return func(topic Topic, params Parameters) error {
+		if id == "" {
+			return fmt.Errorf("%w: empty provider_id", ErrInvalidParameter)
+		}
+		return setParameter(topic, params, ParamProviderID, id)
+	}
+}
+
+// WithProviderType filters the subscription data by location provider type.
+func WithProviderType(t LocationProviderType) Parameter {
+	return func(topic Topic, params Parameters) error {
+		name := t.String()
+		if name == "" {
+			return fmt.Errorf("%w: unknown provider_type %d", ErrInvalidParameter, t)
+		}
+		return setParameter(topic, params, ParamProviderType, name)
+	}
+}
+
+// WithTrackableID filters the subscription data by trackable.
+func WithTrackableID(id uuid.UUID) Parameter {
+	return func(topic Topic, params Parameters) error {
+		return setParameter(topic, params, ParamTrackableID, id.String())
+	}
+}
+
+// WithFenceID filters the subscription data by fence.
+func WithFenceID(id uuid.UUID) Parameter {
+	return func(topic Topic, params Parameters) error {
+		return setParameter(topic, params, ParamFenceID, id.String())
+	}
+}
+
+// WithParameter sets a raw key-value parameter without any validation.
+// It can be used for vendor specific parameters or topics.
+func WithParameter(name, value string) Parameter {
+	return func(topic Topic, params Parameters) error {
+		if name == "" {
+			return fmt.Errorf("%w: empty parameter name", ErrInvalidParameter)
+		}
+		params[name] = value
+		return nil
+	}
+}
+
+// setParameter sets the parameter if it is supported by the topic.
+// Topics unknown to the library (e.g. vendor topics) accept any parameter.
+func setParameter(topic Topic, params Parameters, name, value string) error {
+	supported, ok := topicParameters[topic]
+	if ok && !slices.Contains(supported, name) {
+		return fmt.Errorf("%w: topic '%s' does not support the '%s' parameter", ErrUnsupportedParameter, topic, name)
+	}
+
+	params[name] = value
+	return nil
+}
+
+// equalParameters reports if both parameters are equal, ignoring the access token
+// which is set by the client on every message.
+func equalParameters(a, b Parameters) bool {
+	return maps.Equal(withoutAccessToken(a), withoutAccessToken(b))
+}
+
+// withoutAccessToken returns the parameters without the access token.
+func withoutAccessToken(params Parameters) Parameters {
+	if _, ok := params[ParamAccessToken]; !ok {
+		return params
+	}
+
+	clone := maps.Clone(params)
+	delete(clone, ParamAccessToken)
+	return clone
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestParameters(t *testing.T) {
+	id := uuid.MustParse("9b59961e-2a6a-4712-86e7-aba5a3e8be1f")
+
+	cases := []struct {
+		name     string
+		topic    Topic
+		param    Parameter
+		expected Parameters
+		err      error
+	}{
+		{"crs-local", TopicLocationUpdates, WithCRS("local"), Parameters{"crs": "local"}, nil},
+		{"crs-epsg", TopicTrackableMotions, WithCRS("EPSG:4326"), Parameters{"crs": "EPSG:4326"}, nil},
+		{"crs-invalid", TopicLocationUpdates, WithCRS("wgs84"), Parameters{}, ErrInvalidParameter},
+		{"crs-unsupported", TopicFenceEvents, WithCRS("local"), Parameters{}, ErrUnsupportedParameter},
+		{"zone-id", TopicLocationUpdates, WithZoneID(id), Parameters{"zone_id": id.String()}, nil},
+		{"zone-id-unsupported", TopicCollisionEvents, WithZoneID(id), Parameters{}, ErrUnsupportedParameter},
+		{"provider-id", TopicFenceEvents, WithProviderID("77:4f:34:69:27:40"), Parameters{"provider_id": "77:4f:34:69:27:40"}, nil},
+		{"provider-id-empty", TopicFenceEvents, WithProviderID(""), Parameters{}, ErrInvalidParameter},
+		{"provider-type", TopicLocationUpdates, WithProviderType(LocationProviderTypeUwb), Parameters{"provider_type": "uwb"}, nil},
+		{"provider-type-invalid", TopicLocationUpdates, WithProviderType(LocationProviderType(99)), Parameters{}, ErrInvalidParameter},
+		{"trackable-id", TopicTrackableMotions, WithTrackableID(id), Parameters{"trackable_id": id.String()}, nil},
+		{"fence-id", TopicFenceEventsGeoJSON, WithFenceID(id), Parameters{"fence_id": id.String()}, nil},
+		{"fence-id-unsupported", TopicLocationUpdates, WithFenceID(id), Parameters{}, ErrUnsupportedParameter},
+		{"vendor-topic", Topic("vendor_topic"), WithFenceID(id), Parameters{"fence_id": id.String()}, nil},
+		{"raw", TopicLocationUpdates, WithParameter("org.vendor.filter", "x"), Parameters{"org.vendor.filter": "x"}, nil},
+		{"raw-empty", TopicLocationUpdates, WithParameter("", "x"), Parameters{}, ErrInvalidParameter},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := make(Parameters)
+
+			if err := tc.param(tc.topic, params); !errors.Is(err, tc.err) {
+				t.Fatalf("got error %v, want %v", err, tc.err)
+			}
+
+			if diff := cmp.Diff(tc.expected, params); diff != "" {
+				t.Errorf("Parameters mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
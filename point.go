@@ -4,6 +4,7 @@
 package omlox
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/tidwall/geojson"
@@ -47,3 +48,14 @@ func (p *Point) UnmarshalJSON(data []byte) error {
 func (p Point) Equal(u Point) bool {
 	return p.WithinPoint(u.Base())
 }
+
+// hasZ reports if the point has a z coordinate, even if it is zero.
+func (p Point) hasZ() bool {
+	var v struct {
+		Coordinates []float64 `json:"coordinates"`
+	}
+	if err := json.Unmarshal([]byte(p.JSON()), &v); err != nil {
+		return false
+	}
+	return len(v.Coordinates) > 2
+}
@@ -107,7 +107,7 @@ func (t LocationProviderType) String() string {
 		"virtual",
 	}
 
-	if len(types) < int(t) {
+	if t < 0 || int(t) >= len(types) {
 		return ""
 	}
 
@@ -14,7 +14,7 @@ type ProvidersAPI struct {
 }
 
 // List lists all location providers.
-func (c *ProvidersAPI) List(ctx context.Context) ([]LocationProvider, error) {
+func (c *ProvidersAPI) List(ctx context.Context, opts ...RequestOption) ([]LocationProvider, error) {
 	requestPath := "/providers/summary"
 
 	return sendRequestParseResponseList[LocationProvider](
@@ -25,11 +25,12 @@ func (c *ProvidersAPI) List(ctx context.Context) ([]LocationProvider, error) {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // IDs lists all location providers IDs.
-func (c *ProvidersAPI) IDs(ctx context.Context) ([]string, error) {
+func (c *ProvidersAPI) IDs(ctx context.Context, opts ...RequestOption) ([]string, error) {
 	requestPath := "/providers"
 
 	return sendRequestParseResponseList[string](
@@ -40,11 +41,12 @@ func (c *ProvidersAPI) IDs(ctx context.Context) ([]string, error) {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // Create creates a location provider.
-func (c *ProvidersAPI) Create(ctx context.Context, provider LocationProvider) (*LocationProvider, error) {
+func (c *ProvidersAPI) Create(ctx context.Context, provider LocationProvider, opts ...RequestOption) (*LocationProvider, error) {
 	requestPath := "/providers"
 
 	return sendStructuredRequestParseResponse[LocationProvider](
@@ -55,11 +57,12 @@ func (c *ProvidersAPI) Create(ctx context.Context, provider LocationProvider) (*
 		provider,
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // DeleteAll deletes all location providers.
-func (c *ProvidersAPI) DeleteAll(ctx context.Context) error {
+func (c *ProvidersAPI) DeleteAll(ctx context.Context, opts ...RequestOption) error {
 	requestPath := "/providers"
 
 	_, err := sendRequestParseResponse[struct{}](
@@ -70,13 +73,14 @@ func (c *ProvidersAPI) DeleteAll(ctx context.Context) error {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 
 	return err
 }
 
 // Get gets a location provider.
-func (c *ProvidersAPI) Get(ctx context.Context, id string) (*LocationProvider, error) {
+func (c *ProvidersAPI) Get(ctx context.Context, id string, opts ...RequestOption) (*LocationProvider, error) {
 	requestPath := "/providers/" + id
 
 	return sendRequestParseResponse[LocationProvider](
@@ -87,11 +91,12 @@ func (c *ProvidersAPI) Get(ctx context.Context, id string) (*LocationProvider, e
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // Update updates a location provider.
-func (c *ProvidersAPI) Update(ctx context.Context, provider LocationProvider, id string) error {
+func (c *ProvidersAPI) Update(ctx context.Context, provider LocationProvider, id string, opts ...RequestOption) error {
 	requestPath := "/providers/" + id
 
 	_, err := sendStructuredRequestParseResponse[struct{}](
@@ -102,13 +107,14 @@ func (c *ProvidersAPI) Update(ctx context.Context, provider LocationProvider, id
 		provider,
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 
 	return err
 }
 
 // Delete deletes a location provider.
-func (c *ProvidersAPI) Delete(ctx context.Context, id string) error {
+func (c *ProvidersAPI) Delete(ctx context.Context, id string, opts ...RequestOption) error {
 	requestPath := "/providers/" + id
 
 	_, err := sendRequestParseResponse[struct{}](
@@ -119,13 +125,14 @@ func (c *ProvidersAPI) Delete(ctx context.Context, id string) error {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 
 	return err
 }
 
 // UpdateLocation updates the location of a location provider.
-func (c *ProvidersAPI) UpdateLocation(ctx context.Context, location Location, id string) error {
+func (c *ProvidersAPI) UpdateLocation(ctx context.Context, location Location, id string, opts ...RequestOption) error {
 	requestPath := "/providers/" + id + "/location"
 
 	_, err := sendStructuredRequestParseResponse[struct{}](
@@ -136,6 +143,147 @@ func (c *ProvidersAPI) UpdateLocation(ctx context.Context, location Location, id
 		location,
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Fences lists the fences a location provider is currently within.
+func (c *ProvidersAPI) Fences(ctx context.Context, id string, opts ...RequestOption) ([]Fence, error) {
+	requestPath := "/providers/" + id + "/fences"
+
+	return sendRequestParseResponseList[Fence](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// GetLocation gets the last most recent location of a location provider.
+func (c *ProvidersAPI) GetLocation(ctx context.Context, id string, opts ...RequestOption) (*Location, error) {
+	requestPath := "/providers/" + id + "/location"
+
+	return sendRequestParseResponse[Location](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// DeleteLocation deletes the last most recent location of a location provider.
+func (c *ProvidersAPI) DeleteLocation(ctx context.Context, id string, opts ...RequestOption) error {
+	requestPath := "/providers/" + id + "/location"
+
+	_, err := sendRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodDelete,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Locations lists the last most recent locations of all location providers.
+func (c *ProvidersAPI) Locations(ctx context.Context, opts ...RequestOption) ([]Location, error) {
+	requestPath := "/providers/locations"
+
+	return sendRequestParseResponseList[Location](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// UpdateLocations updates the locations of multiple location providers in a single request.
+// The location provider of each location is given by its provider ID.
+func (c *ProvidersAPI) UpdateLocations(ctx context.Context, locations []Location, opts ...RequestOption) error {
+	requestPath := "/providers/locations"
+
+	_, err := sendStructuredRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodPut,
+		requestPath,
+		easyjsonList[Location](locations),
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// DeleteLocations deletes the last most recent locations of all location providers.
+func (c *ProvidersAPI) DeleteLocations(ctx context.Context, opts ...RequestOption) error {
+	requestPath := "/providers/locations"
+
+	_, err := sendRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodDelete,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// UpdateProximity updates the location of a location provider from a proximity detection.
+func (c *ProvidersAPI) UpdateProximity(ctx context.Context, proximity Proximity, id string, opts ...RequestOption) error {
+	requestPath := "/providers/" + id + "/proximity"
+
+	_, err := sendStructuredRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodPut,
+		requestPath,
+		proximity,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// UpdateProximities updates the locations of multiple location providers from proximity detections in a single request.
+// The location provider of each proximity is given by its provider ID.
+func (c *ProvidersAPI) UpdateProximities(ctx context.Context, proximities []Proximity, opts ...RequestOption) error {
+	requestPath := "/providers/proximities"
+
+	_, err := sendStructuredRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodPut,
+		requestPath,
+		easyjsonList[Proximity](proximities),
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
 	)
 
 	return err
@@ -53,3 +53,25 @@ func TestProviderUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestLocationProviderTypeString(t *testing.T) {
+	cases := []struct {
+		name string
+		typ  LocationProviderType
+		want string
+	}{
+		{"first", LocationProviderTypeUnknown, "unknown"},
+		{"last", LocationProviderTypeVirtual, "virtual"},
+		{"one-past-last", LocationProviderTypeVirtual + 1, ""},
+		{"out-of-range", 255, ""},
+		{"negative", -1, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.typ.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Proximity defines model for Proximity.
+// A proximity update is sent by proximity based technologies (e.g. RFID readers or iBeacon receivers),
+// which detect that a location provider is near a known location instead of computing a position.
+//
+//easyjson:json
+type Proximity struct {
+	// Represents the unique identifier of the proximity zone (zone_id or foreign_id) which detected the location provider
+	// (e.g. the zone of an RFID reader or of an iBeacon).
+	Source string `json:"source"`
+
+	// The location provider type which triggered this proximity update.
+	ProviderType LocationProviderType `json:"provider_type"`
+
+	// The location provider unique identifier, e.g. the EPC of an RFID tag or the id of an iBeacon.
+	ProviderID string `json:"provider_id"`
+
+	// The timestamp when the proximity was detected.
+	// The timestamp MUST be an ISO 8601 timestamp using UTC timezone and it SHOULD have millisecond precision.
+	// If no timestamp is provided, the hub will use its local current time.
+	TimestampGenerated *time.Time `json:"timestamp_generated,omitempty"`
+
+	// The timestamp when the proximity was sent over the network.
+	// The optional timestamp MUST be an ISO 8601 timestamp using UTC timezone and it SHOULD have millisecond precision.
+	TimestampSent *time.Time `json:"timestamp_sent,omitempty"`
+
+	// The estimated distance in meters between the location provider and the proximity zone position.
+	// If not set, the radius of the proximity zone is assumed.
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	// Any additional application or vendor specific properties. An application implementing this  object is not required to interpret
+	// any of the custom properties, but it MUST preserve the properties if set.
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var proximityJSONTestCases = []struct {
+	name      string
+	proximity Proximity
+	json      []byte
+}{
+	{
+		name: "required",
+		proximity: Proximity{
+			Source:       "rfid-gate-02",
+			ProviderType: LocationProviderTypeRfid,
+			ProviderID:   "E2801160600002084F3C2A11",
+		},
+		json: []byte(`{"source":"rfid-gate-02","provider_type":"rfid","provider_id":"E2801160600002084F3C2A11"}`),
+	},
+	{
+		name: "fully-populated",
+		proximity: Proximity{
+			Source:             "f4c05a2b-afd3-41a0-88e2-46f69bdb192e",
+			ProviderType:       LocationProviderTypeIbeacon,
+			ProviderID:         "ac:23:3f:af:f3:90",
+			TimestampGenerated: mustParseTime("2023-10-17T11:14:37.206Z"),
+			TimestampSent:      mustParseTime("2023-10-17T11:14:37.213Z"),
+			Accuracy:           opt(1.5),
+			Properties:         json.RawMessage(`{"org.wavecom.rssi":-67}`),
+		},
+		json: []byte(`{"source":"f4c05a2b-afd3-41a0-88e2-46f69bdb192e","provider_type":"ibeacon","provider_id":"ac:23:3f:af:f3:90","timestamp_generated":"2023-10-17T11:14:37.206Z","timestamp_sent":"2023-10-17T11:14:37.213Z","accuracy":1.5,"properties":{"org.wavecom.rssi":-67}}`),
+	},
+}
+
+func TestProximityMarshal(t *testing.T) {
+	for _, tc := range proximityJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONMarshalOK(t, tc.proximity, tc.json)
+		})
+	}
+}
+
+func TestProximityUnmarshal(t *testing.T) {
+	for _, tc := range proximityJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONUnmarshalOK(t, tc.json, tc.proximity)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RequestOption configures a single REST request, such as its headers, query parameters or timeout.
+// Subscription parameters (e.g. [WithCRS]) are also request options, setting the query parameters of the same name.
+type RequestOption interface {
+	applyRequest(*requestConfig) error
+}
+
+// requestOptionFunc adapts a function to a RequestOption.
+type requestOptionFunc func(*requestConfig) error
+
+func (f requestOptionFunc) applyRequest(c *requestConfig) error {
+	return f(c)
+}
+
+// requestConfig holds the configuration of a single request.
+type requestConfig struct {
+	query   url.Values
+	headers http.Header
+
+	// overrides the client request timeout, if set.
+	timeout    time.Duration
+	hasTimeout bool
+}
+
+// newRequestConfig applies the options over the given request query parameters and headers, without modifying them.
+func newRequestConfig(parameters url.Values, headers http.Header, opts []RequestOption) (requestConfig, error) {
+	config := requestConfig{
+		query:   parameters,
+		headers: headers,
+	}
+
+	if len(opts) == 0 {
+		return config, nil
+	}
+
+	config.query = cloneValues(parameters)
+	config.headers = headers.Clone()
+	if config.headers == nil {
+		config.headers = make(http.Header)
+	}
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt.applyRequest(&config); err != nil {
+			return requestConfig{}, err
+		}
+	}
+
+	return config, nil
+}
+
+// requestTimeout returns the timeout of the request, or the given client-level timeout if not overridden.
+func (c *requestConfig) requestTimeout(clientTimeout time.Duration) time.Duration {
+	if c.hasTimeout {
+		return c.timeout
+	}
+	return clientTimeout
+}
+
+// cloneValues returns a copy of the url values that can be modified.
+func cloneValues(values url.Values) url.Values {
+	clone := make(url.Values, len(values))
+	for k, v := range values {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
+
+// WithHeader sets a header of the request (e.g. a correlation ID).
+func WithHeader(key, value string) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) error {
+		if key == "" {
+			return fmt.Errorf("header name must not be empty")
+		}
+		c.headers.Set(key, value)
+		return nil
+	})
+}
+
+// WithQuery sets a query parameter of the request.
+func WithQuery(key, value string) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) error {
+		if key == "" {
+			return fmt.Errorf("query parameter name must not be empty")
+		}
+		c.query.Set(key, value)
+		return nil
+	})
+}
+
+// WithTimeout limits the duration of the request, overriding the client request timeout.
+// A zero value disables the timeout.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) error {
+		if timeout < 0 {
+			return fmt.Errorf("request timeout must not be negative")
+		}
+		c.timeout = timeout
+		c.hasTimeout = true
+		return nil
+	})
+}
+
+// applyRequest sets the parameter as a query parameter of the request.
+func (p Parameter) applyRequest(c *requestConfig) error {
+	params := make(Parameters)
+
+	// requests are not bound to any topic
+	if err := p("", params); err != nil {
+		return err
+	}
+
+	for name, value := range params {
+		c.query.Set(name, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRequestOptions(t *testing.T) {
+	reqs := make(chan *http.Request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs <- r
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Trackables.GetLocation(context.Background(), uuid.Nil,
+		WithCRS("EPSG:4326"),
+		WithQuery("foo", "bar"),
+		WithHeader("X-Correlation-ID", "abc"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := <-reqs
+
+	want := url.Values{"crs": {"EPSG:4326"}, "foo": {"bar"}}
+	if got := r.URL.Query(); got.Encode() != want.Encode() {
+		t.Errorf("query = %v, want %v", got, want)
+	}
+
+	if got := r.Header.Get("X-Correlation-ID"); got != "abc" {
+		t.Errorf("X-Correlation-ID = %q, want %q", got, "abc")
+	}
+
+	// options do not leak into later requests
+	if _, err := c.Trackables.GetLocation(context.Background(), uuid.Nil); err != nil {
+		t.Fatal(err)
+	}
+
+	r = <-reqs
+	if r.URL.RawQuery != "" || r.Header.Get("X-Correlation-ID") != "" {
+		t.Errorf("request has query %q and correlation ID %q", r.URL.RawQuery, r.Header.Get("X-Correlation-ID"))
+	}
+}
+
+func TestRequestOptionErrors(t *testing.T) {
+	c, err := New("http://localhost")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Trackables.GetLocation(context.Background(), uuid.Nil, WithCRS("invalid")); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidParameter)
+	}
+
+	if _, err := c.Zones.List(context.Background(), WithTimeout(-time.Second)); err == nil {
+		t.Error("expected negative timeout error")
+	}
+
+	if _, err := c.Zones.List(context.Background(), WithHeader("", "value")); err == nil {
+		t.Error("expected empty header error")
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL, WithRequestTimeout(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Zones.List(context.Background(), WithTimeout(20*time.Millisecond)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// a zero timeout disables the client request timeout
+	c, err = New(srv.URL, WithRequestTimeout(20*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Zones.List(context.Background(), WithTimeout(0)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+)
+
+// Errors
+var (
+	ErrSpoolClosed = errors.New("spool closed")
+)
+
+// Spool queues the messages published while the client is disconnected from the hub,
+// so that they are replayed in order once the client reconnects (see [WithSpool]).
+// Implementations must be safe for concurrent use.
+type Spool interface {
+	// Push queues the message, dropping the oldest messages if the spool is full.
+	// The message must not be retained, as the caller may reuse its buffers once Push returns.
+	Push(msg *WrapperObject) error
+
+	// Peek returns the oldest queued message, or nil if the spool is empty.
+	// Messages older than the maximum age of the spool are dropped.
+	Peek() (*WrapperObject, error)
+
+	// Pop removes the oldest queued message, after it has been replayed.
+	Pop() error
+
+	// Reject removes the oldest queued message, after it failed to be replayed
+	// for a reason other than the connection being down.
+	Reject() error
+
+	// Len returns the number of queued messages.
+	Len() int
+
+	// Stats returns the spool metrics.
+	Stats() SpoolStats
+}
+
+// SpoolStats are the metrics of a spool.
+type SpoolStats struct {
+	// Messages and bytes currently queued.
+	Messages int
+	Bytes    int64
+
+	// Total of messages queued and replayed.
+	Spooled  uint64
+	Replayed uint64
+
+	// Total of messages removed because they failed to be replayed
+	// for a reason other than the connection being down.
+	Rejected uint64
+
+	// Total of messages dropped for being older than the maximum age
+	// or to make room for newer messages.
+	DroppedExpired  uint64
+	DroppedOverflow uint64
+}
+
+// SpoolOption is a configuration option of a spool.
+type SpoolOption func(*spoolConfig) error
+
+// spoolConfig is the configuration of a spool.
+type spoolConfig struct {
+	maxAge   time.Duration
+	maxBytes int64
+}
+
+// WithSpoolMaxAge drops queued messages older than the given age instead of replaying them.
+// A zero age keeps messages until they are replayed.
+//
+// Default: 0
+func WithSpoolMaxAge(age time.Duration) SpoolOption {
+	return func(c *spoolConfig) error {
+		if age < 0 {
+			return fmt.Errorf("spool max age must not be negative")
+		}
+		c.maxAge = age
+		return nil
+	}
+}
+
+// WithSpoolMaxBytes limits the size of the queued messages.
+// The oldest messages are dropped to make room for newer ones.
+//
+// Default: 16MiB
+func WithSpoolMaxBytes(size int64) SpoolOption {
+	return func(c *spoolConfig) error {
+		if size < 1 {
+			return fmt.Errorf("spool max bytes must be positive")
+		}
+		c.maxBytes = size
+		return nil
+	}
+}
+
+// newSpoolConfig applies the options to the default spool configuration.
+func newSpoolConfig(options ...SpoolOption) (spoolConfig, error) {
+	config := spoolConfig{
+		maxBytes: 16 << 20,
+	}
+
+	for _, opt := range options {
+		if opt != nil {
+			if err := opt(&config); err != nil {
+				return spoolConfig{}, err
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// expired reports if a message queued at the given time is older than the maximum age.
+func (c spoolConfig) expired(at time.Time) bool {
+	return c.maxAge > 0 && time.Since(at) > c.maxAge
+}
+
+// messageSize returns the size accounted for a message in memory.
+func messageSize(msg *WrapperObject) int64 {
+	size := int64(len(msg.Topic))
+	for _, p := range msg.Payload {
+		size += int64(len(p))
+	}
+	return size
+}
+
+// cloneMessage returns a deep copy of the message, so that it isn't affected
+// by the caller reusing its parameters or payload buffers.
+// The payloads are copied into a single buffer.
+func cloneMessage(msg *WrapperObject) *WrapperObject {
+	clone := *msg
+
+	clone.Params = maps.Clone(msg.Params)
+
+	if msg.Payload != nil {
+		n := 0
+		for _, p := range msg.Payload {
+			n += len(p)
+		}
+
+		buf := make([]byte, 0, n)
+		clone.Payload = make([]json.RawMessage, len(msg.Payload))
+		for i, p := range msg.Payload {
+			buf = append(buf, p...)
+			clone.Payload[i] = buf[len(buf)-len(p) : len(buf) : len(buf)]
+		}
+	}
+
+	return &clone
+}
+
+// MemorySpool is a spool that keeps the messages in memory.
+type MemorySpool struct {
+	config spoolConfig
+
+	mu    sync.Mutex
+	items []spooled
+	bytes int64
+	stats SpoolStats
+}
+
+var _ Spool = (*MemorySpool)(nil)
+
+// spooled is a message queued in memory.
+type spooled struct {
+	msg  *WrapperObject
+	at   time.Time
+	size int64
+}
+
+// NewMemorySpool returns a spool that keeps the messages in memory.
+func NewMemorySpool(options ...SpoolOption) (*MemorySpool, error) {
+	config, err := newSpoolConfig(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemorySpool{config: config}, nil
+}
+
+// Push queues a copy of the message, dropping the oldest messages if the spool is full.
+func (s *MemorySpool) Push(msg *WrapperObject) error {
+	item := spooled{msg: cloneMessage(msg), at: time.Now(), size: messageSize(msg)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if item.size > s.config.maxBytes {
+		s.stats.DroppedOverflow++
+		return nil
+	}
+
+	for s.bytes+item.size > s.config.maxBytes {
+		s.drop()
+		s.stats.DroppedOverflow++
+	}
+
+	s.items = append(s.items, item)
+	s.bytes += item.size
+	s.stats.Spooled++
+
+	return nil
+}
+
+// Peek returns the oldest queued message, or nil if the spool is empty.
+func (s *MemorySpool) Peek() (*WrapperObject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.items) != 0 {
+		if !s.config.expired(s.items[0].at) {
+			return s.items[0].msg, nil
+		}
+
+		s.drop()
+		s.stats.DroppedExpired++
+	}
+
+	return nil, nil
+}
+
+// Pop removes the oldest queued message.
+func (s *MemorySpool) Pop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.items) != 0 {
+		s.drop()
+		s.stats.Replayed++
+	}
+
+	return nil
+}
+
+// Reject removes the oldest queued message, after it failed to be replayed.
+func (s *MemorySpool) Reject() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.items) != 0 {
+		s.drop()
+		s.stats.Rejected++
+	}
+
+	return nil
+}
+
+// Len returns the number of queued messages.
+func (s *MemorySpool) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// Stats returns the spool metrics.
+func (s *MemorySpool) Stats() SpoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats
+	stats.Messages = len(s.items)
+	stats.Bytes = s.bytes
+
+	return stats
+}
+
+// drop removes the oldest message.
+// The spool lock must be held by the caller.
+func (s *MemorySpool) drop() {
+	s.bytes -= s.items[0].size
+	s.items[0] = spooled{}
+	s.items = s.items[1:]
+}
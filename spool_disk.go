@@ -0,0 +1,548 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// file extension of the spool segments.
+	segmentExt = ".seg"
+
+	// name of the file holding the read position of the spool.
+	cursorFile = "cursor"
+
+	// size of the record header: body length (4 bytes) and time it was queued (8 bytes).
+	recordHeaderSize = 12
+
+	// bounds of the segment size, which is a fraction of the spool size.
+	minSegmentSize = 64 << 10
+	maxSegmentSize = 4 << 20
+)
+
+// DiskSpool is a spool that keeps the messages in a bounded log of segment files,
+// so that they outlive the process. Messages are appended to the newest segment
+// and replayed from the oldest, which is removed once fully replayed.
+//
+// Writes are not synced to disk, so the messages queued right before
+// a crash of the operating system may be lost.
+type DiskSpool struct {
+	config spoolConfig
+	dir    string
+
+	// size after which a new segment is started.
+	segmentSize int64
+
+	mu     sync.Mutex
+	closed bool
+
+	// segments, from the oldest to the newest, which is opened for appending.
+	segments []*segment
+	w        *os.File
+
+	// read position in the oldest segment, persisted to the cursor file.
+	offset int64
+	cursor *os.File
+
+	// oldest record, cached between peek and pop.
+	head *record
+
+	count int
+	bytes int64
+	stats SpoolStats
+}
+
+var _ Spool = (*DiskSpool)(nil)
+
+// segment is a file of the spool log.
+type segment struct {
+	id   uint64
+	size int64
+
+	// opened for reading, when it is the oldest segment.
+	r *os.File
+}
+
+// record is a message stored in a segment.
+type record struct {
+	msg  *WrapperObject
+	at   time.Time
+	size int64
+}
+
+// OpenDiskSpool opens (or creates) a spool in the given directory.
+// Messages left in the directory by a previous process are kept to be replayed.
+// The directory must not be shared by other spools.
+func OpenDiskSpool(dir string, options ...SpoolOption) (*DiskSpool, error) {
+	config, err := newSpoolConfig(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	s := &DiskSpool{
+		config:      config,
+		dir:         dir,
+		segmentSize: min(max(config.maxBytes/8, minSegmentSize), maxSegmentSize),
+	}
+
+	if err := s.open(); err != nil {
+		s.closeFiles()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// open restores the segments and the read position from the directory.
+func (s *DiskSpool) open() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), segmentExt)
+		if !ok || e.IsDir() {
+			continue
+		}
+
+		id, err := strconv.ParseUint(name, 16, 64)
+		if err != nil {
+			continue
+		}
+
+		s.segments = append(s.segments, &segment{id: id})
+	}
+
+	slices.SortFunc(s.segments, func(a, b *segment) int {
+		switch {
+		case a.id < b.id:
+			return -1
+		case a.id > b.id:
+			return 1
+		}
+		return 0
+	})
+
+	s.cursor, err = os.OpenFile(filepath.Join(s.dir, cursorFile), os.O_RDWR|os.O_CREATE, 0o640)
+	if err != nil {
+		return err
+	}
+
+	var pos [16]byte
+	if _, err := s.cursor.ReadAt(pos[:], 0); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	cursorID, cursorOffset := binary.BigEndian.Uint64(pos[:8]), int64(binary.BigEndian.Uint64(pos[8:]))
+
+	// remove the segments already replayed
+	for len(s.segments) != 0 && s.segments[0].id < cursorID {
+		if err := os.Remove(s.segmentPath(s.segments[0].id)); err != nil {
+			return err
+		}
+		s.segments = s.segments[1:]
+	}
+
+	if len(s.segments) != 0 && s.segments[0].id == cursorID {
+		s.offset = cursorOffset
+	}
+
+	for i, seg := range s.segments {
+		offset := int64(0)
+		if i == 0 {
+			offset = s.offset
+		}
+
+		if err := s.scan(seg, offset); err != nil {
+			return err
+		}
+	}
+
+	// remove the oldest segment if it was fully read
+	if len(s.segments) > 1 && s.offset >= s.segments[0].size {
+		if err := os.Remove(s.segmentPath(s.segments[0].id)); err != nil {
+			return err
+		}
+		s.segments = s.segments[1:]
+		s.offset = 0
+	}
+
+	if len(s.segments) != 0 {
+		s.offset = min(s.offset, s.segments[0].size)
+	}
+
+	if len(s.segments) == 0 {
+		return s.rotate()
+	}
+
+	last := s.segments[len(s.segments)-1]
+	s.w, err = os.OpenFile(s.segmentPath(last.id), os.O_WRONLY|os.O_APPEND, 0o640)
+	return err
+}
+
+// scan counts the records of the segment from the offset,
+// truncating any partially written record at its end.
+func (s *DiskSpool) scan(seg *segment, offset int64) error {
+	f, err := os.OpenFile(s.segmentPath(seg.id), os.O_RDWR, 0o640)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	seg.size = info.Size()
+
+	var hdr [recordHeaderSize]byte
+	for offset < seg.size {
+		if _, err := f.ReadAt(hdr[:], offset); err != nil {
+			break
+		}
+
+		size := recordHeaderSize + int64(binary.BigEndian.Uint32(hdr[:4]))
+		if offset+size > seg.size {
+			break
+		}
+
+		offset += size
+		s.count++
+		s.bytes += size
+	}
+
+	if offset < seg.size {
+		seg.size = offset
+		return f.Truncate(offset)
+	}
+
+	return nil
+}
+
+// Push queues the message, dropping the oldest messages if the spool is full.
+func (s *DiskSpool) Push(msg *WrapperObject) error {
+	body, err := msg.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	size := recordHeaderSize + int64(len(body))
+
+	buf := make([]byte, recordHeaderSize, size)
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(body)))
+	binary.BigEndian.PutUint64(buf[4:], uint64(time.Now().UnixNano()))
+	buf = append(buf, body...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrSpoolClosed
+	}
+
+	if size > s.config.maxBytes {
+		s.stats.DroppedOverflow++
+		return nil
+	}
+
+	for s.bytes+size > s.config.maxBytes {
+		if err := s.drop(); err != nil {
+			return err
+		}
+		s.stats.DroppedOverflow++
+	}
+
+	if last := s.segments[len(s.segments)-1]; last.size > 0 && last.size+size > s.segmentSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.w.Write(buf); err != nil {
+		return err
+	}
+
+	s.segments[len(s.segments)-1].size += size
+	s.count++
+	s.bytes += size
+	s.stats.Spooled++
+
+	return nil
+}
+
+// Peek returns the oldest queued message, or nil if the spool is empty.
+func (s *DiskSpool) Peek() (*WrapperObject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrSpoolClosed
+	}
+
+	for s.count != 0 {
+		rec, err := s.peek()
+		if err != nil {
+			return nil, err
+		}
+
+		if rec.msg != nil && !s.config.expired(rec.at) {
+			return rec.msg, nil
+		}
+
+		if err := s.drop(); err != nil {
+			return nil, err
+		}
+
+		if rec.msg != nil {
+			s.stats.DroppedExpired++
+		}
+	}
+
+	return nil, nil
+}
+
+// Pop removes the oldest queued message.
+func (s *DiskSpool) Pop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrSpoolClosed
+	}
+
+	if s.count == 0 {
+		return nil
+	}
+
+	if err := s.drop(); err != nil {
+		return err
+	}
+	s.stats.Replayed++
+
+	return nil
+}
+
+// Reject removes the oldest queued message, after it failed to be replayed.
+func (s *DiskSpool) Reject() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrSpoolClosed
+	}
+
+	if s.count == 0 {
+		return nil
+	}
+
+	if err := s.drop(); err != nil {
+		return err
+	}
+	s.stats.Rejected++
+
+	return nil
+}
+
+// Len returns the number of queued messages.
+func (s *DiskSpool) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
+
+// Stats returns the spool metrics.
+func (s *DiskSpool) Stats() SpoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats
+	stats.Messages = s.count
+	stats.Bytes = s.bytes
+
+	return stats
+}
+
+// Close releases the files of the spool.
+// The queued messages are kept in the directory.
+func (s *DiskSpool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	return s.closeFiles()
+}
+
+// peek reads the oldest record.
+// Records that can't be decoded are returned without message, to be dropped.
+// The spool lock must be held by the caller.
+func (s *DiskSpool) peek() (*record, error) {
+	if s.head != nil {
+		return s.head, nil
+	}
+
+	seg := s.segments[0]
+	if seg.r == nil {
+		f, err := os.Open(s.segmentPath(seg.id))
+		if err != nil {
+			return nil, err
+		}
+		seg.r = f
+	}
+
+	var hdr [recordHeaderSize]byte
+	if _, err := seg.r.ReadAt(hdr[:], s.offset); err != nil {
+		return nil, err
+	}
+
+	body := make([]byte, binary.BigEndian.Uint32(hdr[:4]))
+	if _, err := seg.r.ReadAt(body, s.offset+recordHeaderSize); err != nil {
+		return nil, err
+	}
+
+	rec := &record{
+		at:   time.Unix(0, int64(binary.BigEndian.Uint64(hdr[4:]))),
+		size: recordHeaderSize + int64(len(body)),
+	}
+
+	msg := new(WrapperObject)
+	if err := msg.UnmarshalJSON(body); err != nil {
+		slog.LogAttrs(context.Background(), slog.LevelWarn, "dropping corrupted spool record", slog.Any("err", err))
+	} else {
+		rec.msg = msg
+	}
+
+	s.head = rec
+	return rec, nil
+}
+
+// drop removes the oldest record, removing its segment once fully read.
+// The spool lock must be held by the caller.
+func (s *DiskSpool) drop() error {
+	rec, err := s.peek()
+	if err != nil {
+		return err
+	}
+
+	s.head = nil
+	s.offset += rec.size
+	s.count--
+	s.bytes -= rec.size
+
+	seg := s.segments[0]
+	if s.offset >= seg.size {
+		if len(s.segments) > 1 {
+			// the segment was fully replayed
+			if seg.r != nil {
+				seg.r.Close()
+			}
+			if err := os.Remove(s.segmentPath(seg.id)); err != nil {
+				return err
+			}
+
+			s.segments = s.segments[1:]
+			s.offset = 0
+		} else if err := s.reset(); err != nil {
+			return err
+		}
+	}
+
+	return s.saveCursor()
+}
+
+// reset truncates the newest segment once it was fully read.
+// The spool lock must be held by the caller.
+func (s *DiskSpool) reset() error {
+	if err := s.w.Truncate(0); err != nil {
+		return err
+	}
+
+	s.segments[0].size = 0
+	s.offset = 0
+
+	return nil
+}
+
+// rotate starts a new segment.
+// The spool lock must be held by the caller.
+func (s *DiskSpool) rotate() error {
+	id := uint64(1)
+	if len(s.segments) != 0 {
+		id = s.segments[len(s.segments)-1].id + 1
+	}
+
+	f, err := os.OpenFile(s.segmentPath(id), os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_EXCL, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if s.w != nil {
+		s.w.Close()
+	}
+
+	s.w = f
+	s.segments = append(s.segments, &segment{id: id})
+
+	if len(s.segments) == 1 {
+		return s.saveCursor()
+	}
+
+	return nil
+}
+
+// saveCursor persists the read position.
+// The spool lock must be held by the caller.
+func (s *DiskSpool) saveCursor() error {
+	var pos [16]byte
+	binary.BigEndian.PutUint64(pos[:8], s.segments[0].id)
+	binary.BigEndian.PutUint64(pos[8:], uint64(s.offset))
+
+	_, err := s.cursor.WriteAt(pos[:], 0)
+	return err
+}
+
+// closeFiles closes every open file of the spool.
+func (s *DiskSpool) closeFiles() error {
+	var errs []error
+
+	for _, seg := range s.segments {
+		if seg.r != nil {
+			errs = append(errs, seg.r.Close())
+		}
+	}
+
+	if s.w != nil {
+		errs = append(errs, s.w.Close())
+	}
+
+	if s.cursor != nil {
+		errs = append(errs, s.cursor.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *DiskSpool) segmentPath(id uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%016x%s", id, segmentExt))
+}
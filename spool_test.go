@@ -0,0 +1,462 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"nhooyr.io/websocket"
+)
+
+// spoolMsg returns a message with a single payload of the given size in bytes.
+func spoolMsg(n, size int) *WrapperObject {
+	p := fmt.Sprintf(`{"n":%d,"pad":"`, n)
+	for len(p) < size-2 {
+		p += "x"
+	}
+	p += `"}`
+
+	return &WrapperObject{Event: EventMsg, Topic: "t", Payload: []json.RawMessage{json.RawMessage(p)}}
+}
+
+// spoolN returns the sequence number of the message payload.
+func spoolN(t *testing.T, msg *WrapperObject) int {
+	t.Helper()
+
+	var v struct{ N int }
+	if err := json.Unmarshal(msg.Payload[0], &v); err != nil {
+		t.Fatal(err)
+	}
+	return v.N
+}
+
+// drainSpoolOK pops every message, returning their sequence numbers.
+func drainSpoolOK(t *testing.T, s Spool) []int {
+	t.Helper()
+
+	var got []int
+	for {
+		msg, err := s.Peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg == nil {
+			return got
+		}
+
+		got = append(got, spoolN(t, msg))
+
+		if err := s.Pop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// spoolImpls returns constructors of each spool implementation.
+func spoolImpls(t *testing.T) map[string]func(options ...SpoolOption) Spool {
+	return map[string]func(options ...SpoolOption) Spool{
+		"memory": func(options ...SpoolOption) Spool {
+			s, err := NewMemorySpool(options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+		"disk": func(options ...SpoolOption) Spool {
+			s, err := OpenDiskSpool(t.TempDir(), options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { s.Close() })
+			return s
+		},
+	}
+}
+
+func TestSpoolOrder(t *testing.T) {
+	for name, newSpool := range spoolImpls(t) {
+		t.Run(name, func(t *testing.T) {
+			s := newSpool()
+
+			for i := 0; i < 5; i++ {
+				if err := s.Push(spoolMsg(i, 32)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if n := s.Len(); n != 5 {
+				t.Errorf("len = %d, want 5", n)
+			}
+
+			if diff := cmp.Diff([]int{0, 1, 2, 3, 4}, drainSpoolOK(t, s)); diff != "" {
+				t.Errorf("replayed messages mismatch (-want +got):\n%s", diff)
+			}
+
+			stats := s.Stats()
+			if stats.Spooled != 5 || stats.Replayed != 5 || stats.Messages != 0 || stats.Bytes != 0 {
+				t.Errorf("unexpected stats %+v", stats)
+			}
+		})
+	}
+}
+
+func TestSpoolMaxBytes(t *testing.T) {
+	for name, newSpool := range spoolImpls(t) {
+		t.Run(name, func(t *testing.T) {
+			// room for 3 messages, with or without the disk record overhead
+			s := newSpool(WithSpoolMaxBytes(800))
+
+			for i := 0; i < 6; i++ {
+				if err := s.Push(spoolMsg(i, 200)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if diff := cmp.Diff([]int{3, 4, 5}, drainSpoolOK(t, s)); diff != "" {
+				t.Errorf("replayed messages mismatch (-want +got):\n%s", diff)
+			}
+
+			if n := s.Stats().DroppedOverflow; n != 3 {
+				t.Errorf("dropped overflow = %d, want 3", n)
+			}
+		})
+	}
+}
+
+func TestSpoolMaxAge(t *testing.T) {
+	for name, newSpool := range spoolImpls(t) {
+		t.Run(name, func(t *testing.T) {
+			s := newSpool(WithSpoolMaxAge(20 * time.Millisecond))
+
+			s.Push(spoolMsg(0, 32))
+			s.Push(spoolMsg(1, 32))
+			time.Sleep(40 * time.Millisecond)
+			s.Push(spoolMsg(2, 32))
+
+			if diff := cmp.Diff([]int{2}, drainSpoolOK(t, s)); diff != "" {
+				t.Errorf("replayed messages mismatch (-want +got):\n%s", diff)
+			}
+
+			if n := s.Stats().DroppedExpired; n != 2 {
+				t.Errorf("dropped expired = %d, want 2", n)
+			}
+		})
+	}
+}
+
+func TestDiskSpoolReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	// small segments, so that messages span several of them
+	s, err := OpenDiskSpool(dir, WithSpoolMaxBytes(1<<20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.segmentSize = 256
+
+	for i := 0; i < 20; i++ {
+		if err := s.Push(spoolMsg(i, 64)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := len(s.segments); n < 3 {
+		t.Errorf("%d segments, want several", n)
+	}
+
+	// replay some messages before closing
+	for i := 0; i < 7; i++ {
+		s.Peek()
+		s.Pop()
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = OpenDiskSpool(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if n := s.Len(); n != 13 {
+		t.Errorf("reopened spool has %d messages, want 13", n)
+	}
+
+	var want []int
+	for i := 7; i < 20; i++ {
+		want = append(want, i)
+	}
+
+	if diff := cmp.Diff(want, drainSpoolOK(t, s)); diff != "" {
+		t.Errorf("replayed messages mismatch (-want +got):\n%s", diff)
+	}
+
+	if n := len(s.segments); n != 1 {
+		t.Errorf("%d segments after replay, want 1", n)
+	}
+}
+
+func TestClientSpool(t *testing.T) {
+	hub := newFakeHub(t)
+
+	spool, err := NewMemorySpool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(hub.srv.URL, WithSpool(spool), WithReconnect(ReconnectPolicy{InitialInterval: 50 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	states := make(chan ConnectionState, 16)
+	c.OnStateChange(func(s ConnectionState) {
+		states <- s
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	hc := hub.accept(ctx, t)
+	expectStates(t, states, StateConnecting, StateConnected)
+
+	hc.conn.Close(websocket.StatusGoingAway, "restarting")
+	expectStates(t, states, StateReconnecting)
+
+	// published while disconnected
+	locations := testLocations(3)
+	for _, l := range locations {
+		if err := c.PublishLocations(context.Background(), l); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := spool.Len(); n != 3 {
+		t.Fatalf("spooled %d messages, want 3", n)
+	}
+
+	hc = hub.accept(ctx, t)
+
+	// replayed in order on reconnection
+	for _, want := range locations {
+		msg := hc.expect(t, EventMsg)
+
+		var got Location
+		if err := json.Unmarshal(msg.Payload[0], &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if got.ProviderID != want.ProviderID {
+			t.Errorf("replayed location of %s, want %s", got.ProviderID, want.ProviderID)
+		}
+		if got.TimestampGenerated == nil {
+			t.Error("replayed location without timestamp_generated")
+		}
+	}
+
+	if stats := spool.Stats(); stats.Replayed != 3 {
+		t.Errorf("replayed %d messages, want 3", stats.Replayed)
+	}
+}
+
+func TestClientSpoolPublishError(t *testing.T) {
+	hub := newFakeHub(t)
+
+	spool, err := NewMemorySpool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the token is available to connect, but not to publish afterwards
+	errToken := errors.New("token endpoint unavailable")
+	var fail atomic.Bool
+	tokens := TokenSourceFunc(func(ctx context.Context) (*Token, error) {
+		if fail.Load() {
+			return nil, errToken
+		}
+		return &Token{AccessToken: "secret"}, nil
+	})
+
+	c, _ := hub.connect(t, WithSpool(spool), WithTokenSource(tokens))
+	fail.Store(true)
+
+	if err := c.PublishLocations(context.Background(), testLocations(1)...); !errors.Is(err, errToken) {
+		t.Errorf("got error %v, want %v", err, errToken)
+	}
+
+	if n := spool.Len(); n != 0 {
+		t.Errorf("spooled %d messages, want 0", n)
+	}
+}
+
+func TestMemorySpoolCopy(t *testing.T) {
+	spool, err := NewMemorySpool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload := []byte(`{"n":1}`)
+	msg := &WrapperObject{
+		Event:   EventMsg,
+		Topic:   TopicLocationUpdates,
+		Params:  Parameters{ParamCRS: "local"},
+		Payload: []json.RawMessage{payload},
+	}
+
+	if err := spool.Push(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	// the caller reuses its buffers after publishing
+	copy(payload, `{"n":2}`)
+	msg.Params[ParamCRS] = "EPSG:4326"
+
+	got, err := spool.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &WrapperObject{
+		Event:   EventMsg,
+		Topic:   TopicLocationUpdates,
+		Params:  Parameters{ParamCRS: "local"},
+		Payload: []json.RawMessage{json.RawMessage(`{"n":1}`)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("spooled message mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSpoolReject(t *testing.T) {
+	for name, newSpool := range spoolImpls(t) {
+		t.Run(name, func(t *testing.T) {
+			s := newSpool()
+
+			for i := 0; i < 3; i++ {
+				if err := s.Push(spoolMsg(i, 32)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := s.Reject(); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff([]int{1, 2}, drainSpoolOK(t, s)); diff != "" {
+				t.Errorf("replayed messages mismatch (-want +got):\n%s", diff)
+			}
+
+			if stats := s.Stats(); stats.Rejected != 1 || stats.Replayed != 2 || stats.Messages != 0 {
+				t.Errorf("unexpected stats %+v", stats)
+			}
+		})
+	}
+}
+
+func TestClientSpoolReplayRejected(t *testing.T) {
+	hub := newFakeHub(t)
+
+	spool, err := NewMemorySpool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// queued while the client was disconnected
+	for i := 0; i < 2; i++ {
+		if err := spool.Push(spoolMsg(i, 32)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the token is unavailable to replay the first message only
+	errToken := errors.New("token endpoint unavailable")
+	var calls atomic.Int32
+	tokens := TokenSourceFunc(func(ctx context.Context) (*Token, error) {
+		if calls.Add(1) == 2 {
+			return nil, errToken
+		}
+		return &Token{AccessToken: "secret"}, nil
+	})
+
+	c, err := New(hub.srv.URL, WithSpool(spool), WithTokenSource(tokens))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	errs := collectErrors(c)
+
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	hc := hub.accept(ctx, t)
+
+	select {
+	case hubErr := <-errs:
+		if !errors.Is(hubErr, errToken) || hubErr.Request == nil || spoolN(t, hubErr.Request) != 0 {
+			t.Errorf("got error %v for %v, want %v for the first message", hubErr, hubErr.Request, errToken)
+		}
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the rejected message")
+	}
+
+	// the rejected message doesn't block the next ones
+	if n := spoolN(t, hc.expect(t, EventMsg)); n != 1 {
+		t.Errorf("replayed message %d, want 1", n)
+	}
+
+	if stats := spool.Stats(); stats.Rejected != 1 {
+		t.Errorf("rejected %d messages, want 1", stats.Rejected)
+	}
+}
+
+func TestClientSpoolTimestamp(t *testing.T) {
+	hub := newFakeHub(t)
+
+	spool, err := NewMemorySpool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(hub.srv.URL, WithSpool(spool))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	// published while disconnected
+	proximity := Proximity{Source: "zone", ProviderType: LocationProviderTypeRfid, ProviderID: "epc"}
+	if err := c.PublishProximities(context.Background(), proximity); err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := spool.Peek()
+	if err != nil || msg == nil {
+		t.Fatalf("nothing spooled: %v", err)
+	}
+
+	var got Proximity
+	if err := json.Unmarshal(msg.Payload[0], &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.TimestampGenerated == nil {
+		t.Error("spooled proximity without timestamp_generated")
+	}
+}
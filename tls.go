@@ -0,0 +1,108 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+)
+
+// WithTLSConfig sets the TLS configuration used to connect to the hub, replacing
+// any previous one. [WithCACertFile] and [WithClientCertificate] given after it
+// extend the configuration.
+//
+// Default: nil
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *ClientConfiguration) error {
+		if config == nil {
+			c.TLSConfig = nil
+			return nil
+		}
+		c.TLSConfig = config.Clone()
+		return nil
+	}
+}
+
+// WithCACertFile trusts the PEM encoded certificate authorities of the file
+// to verify the hub certificate, instead of the system ones (e.g. for hubs
+// with a certificate issued by an internal CA).
+func WithCACertFile(path string) ClientOption {
+	return func(c *ClientConfiguration) error {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read CA certificate: %w", err)
+		}
+
+		// the pool is shared with the configuration given to WithTLSConfig, so it is copied
+		config := tlsConfig(c)
+		pool := x509.NewCertPool()
+		if config.RootCAs != nil {
+			pool = config.RootCAs.Clone()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no valid certificates found in '%s'", path)
+		}
+
+		config.RootCAs = pool
+		return nil
+	}
+}
+
+// WithClientCertificate authenticates the client to the hub with the PEM encoded
+// certificate and private key files (mutual TLS).
+func WithClientCertificate(certFile, keyFile string) ClientOption {
+	return func(c *ClientConfiguration) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("could not load client certificate: %w", err)
+		}
+
+		config := tlsConfig(c)
+		config.Certificates = append(slices.Clip(config.Certificates), cert)
+
+		return nil
+	}
+}
+
+// tlsConfig returns the TLS configuration, initializing it if not set.
+func tlsConfig(c *ClientConfiguration) *tls.Config {
+	if c.TLSConfig == nil {
+		c.TLSConfig = &tls.Config{}
+	}
+	return c.TLSConfig
+}
+
+// withTLSConfig returns a copy of the HTTP client whose transport uses the TLS configuration.
+func withTLSConfig(client *http.Client, config *tls.Config) (*http.Client, error) {
+	if config == nil {
+		return client, nil
+	}
+
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	var transport *http.Transport
+
+	switch t := client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf("cannot apply the TLS configuration to a %T transport, configure it in the HTTP client instead", t)
+	}
+
+	transport.TLSClientConfig = config.Clone()
+
+	clone := *client
+	clone.Transport = transport
+
+	return &clone, nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"nhooyr.io/websocket"
+)
+
+// writePEM writes the PEM block to a file in the test directory.
+func writePEM(t *testing.T, name, typ string, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: data}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// newClientCertificate generates a self-signed client certificate, returning its certificate and key files.
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "omlox-client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert, writePEM(t, "client.crt", "CERTIFICATE", der), writePEM(t, "client.key", "EC PRIVATE KEY", keyDER)
+}
+
+// newMutualTLSHub returns a hub requiring client certificates issued by the given certificate,
+// serving an empty list on REST requests and accepting websocket connections.
+func newMutualTLSHub(t *testing.T, clientCA *x509.Certificate) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ws/socket" {
+			conn, err := websocket.Accept(w, r, nil)
+			if err != nil {
+				return
+			}
+			conn.Close(websocket.StatusNormalClosure, "")
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	}))
+
+	pool := x509.NewCertPool()
+	pool.AddCert(clientCA)
+
+	// silence the expected handshake errors
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestMutualTLS(t *testing.T) {
+	cert, certFile, keyFile := newClientCertificate(t)
+	srv := newMutualTLSHub(t, cert)
+
+	caFile := writePEM(t, "ca.crt", "CERTIFICATE", srv.Certificate().Raw)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	t.Run("rest", func(t *testing.T) {
+		c, err := New(srv.URL, WithCACertFile(caFile), WithClientCertificate(certFile, keyFile))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		c, err := Connect(ctx, srv.URL, WithCACertFile(caFile), WithClientCertificate(certFile, keyFile))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Close()
+	})
+
+	t.Run("unknown_ca", func(t *testing.T) {
+		c, err := New(srv.URL, WithClientCertificate(certFile, keyFile))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err == nil {
+			t.Fatal("request succeeded with an untrusted hub certificate")
+		}
+	})
+
+	t.Run("no_client_certificate", func(t *testing.T) {
+		c, err := Connect(ctx, srv.URL, WithCACertFile(caFile))
+		if err == nil {
+			c.Close()
+			t.Fatal("connected without a client certificate")
+		}
+	})
+
+	t.Run("tls_config", func(t *testing.T) {
+		tlsCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := New(srv.URL, WithTLSConfig(&tls.Config{InsecureSkipVerify: true, Certificates: []tls.Certificate{tlsCert}}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c.Zones.List(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestTLSConfigNotModified(t *testing.T) {
+	cert, certFile, keyFile := newClientCertificate(t)
+	caFile := writePEM(t, "ca.crt", "CERTIFICATE", cert.Raw)
+
+	pool := x509.NewCertPool()
+	certs := make([]tls.Certificate, 0, 2)
+	config := &tls.Config{RootCAs: pool, Certificates: certs}
+
+	if _, err := New("https://localhost", WithTLSConfig(config), WithCACertFile(caFile), WithClientCertificate(certFile, keyFile)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !pool.Equal(x509.NewCertPool()) {
+		t.Error("certificate authorities added to the given pool")
+	}
+
+	if certs = certs[:1]; certs[0].Leaf != nil || len(certs[0].Certificate) != 0 {
+		t.Error("client certificate added to the given certificates")
+	}
+}
+
+func TestTLSOptionErrors(t *testing.T) {
+	invalid := writePEM(t, "invalid.crt", "CERTIFICATE", []byte("invalid"))
+
+	tests := []struct {
+		name   string
+		option ClientOption
+	}{
+		{"missing_ca", WithCACertFile(filepath.Join(t.TempDir(), "missing.crt"))},
+		{"invalid_ca", WithCACertFile(invalid)},
+		{"invalid_client_certificate", WithClientCertificate(invalid, invalid)},
+		{"custom_transport", func(c *ClientConfiguration) error {
+			c.HTTPClient = &http.Client{Transport: http.NewFileTransport(http.Dir("."))}
+			c.TLSConfig = &tls.Config{}
+			return nil
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New("https://localhost", tt.option); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
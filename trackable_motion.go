@@ -0,0 +1,34 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// TrackableMotion defines model for TrackableMotion.
+// A trackable motion describes the movement of a trackable, given by its most significant location.
+//
+//easyjson:json
+type TrackableMotion struct {
+	// The id of the trackable.
+	ID uuid.UUID `json:"id"`
+
+	// The most significant location of the trackable, considering all recent location updates of
+	// the trackables location providers.
+	Location Location `json:"location"`
+
+	// The name of the trackable.
+	Name string `json:"name,omitempty"`
+
+	// GeoJson Polygon geometry of the trackable, transformed to the trackable location.
+	Geometry *Polygon `json:"geometry,omitempty"`
+
+	// Any additional application or vendor specific properties.
+	// An application implementing this object is not required to interpret any of the custom properties,
+	// but it MUST preserve the properties if set.
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
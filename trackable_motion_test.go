@@ -0,0 +1,71 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/geojson/geometry"
+)
+
+var trackableMotionJSONTestCases = []struct {
+	name   string
+	motion TrackableMotion
+	json   []byte
+}{
+	{
+		name: "required",
+		motion: TrackableMotion{
+			ID: uuid.MustParse("9b59961e-2a6a-4712-86e7-aba5a3e8be1f"),
+			Location: Location{
+				Position:     *NewPoint(geometry.Point{X: 5, Y: 4}),
+				Source:       "fdb6df62-bce8-6c23-e342-80bd5c938774",
+				ProviderType: LocationProviderTypeUwb,
+				ProviderID:   "77:4f:34:69:27:40",
+			},
+		},
+		json: []byte(`{"id":"9b59961e-2a6a-4712-86e7-aba5a3e8be1f","location":{"position":{"type":"Point","coordinates":[5,4]},"source":"fdb6df62-bce8-6c23-e342-80bd5c938774","provider_type":"uwb","provider_id":"77:4f:34:69:27:40"}}`),
+	},
+	{
+		name: "fully-populated",
+		motion: TrackableMotion{
+			ID: uuid.MustParse("9b59961e-2a6a-4712-86e7-aba5a3e8be1f"),
+			Location: Location{
+				Position:           *NewPoint(geometry.Point{X: 5, Y: 4}),
+				Source:             "fdb6df62-bce8-6c23-e342-80bd5c938774",
+				ProviderType:       LocationProviderTypeUwb,
+				ProviderID:         "77:4f:34:69:27:40",
+				TimestampGenerated: mustParseTime("2019-09-02T22:02:24.355Z"),
+			},
+			Name: "Forklift",
+			Geometry: NewPolygon(geometry.NewPoly([]geometry.Point{
+				{X: 4, Y: 3},
+				{X: 6, Y: 3},
+				{X: 6, Y: 5},
+				{X: 4, Y: 5},
+				{X: 4, Y: 3},
+			}, nil, geometry.DefaultIndexOptions)),
+			Properties: json.RawMessage(`{"org.wavecom.whereis":{"eid":"FL0002"}}`),
+		},
+		json: []byte(`{"id":"9b59961e-2a6a-4712-86e7-aba5a3e8be1f","location":{"position":{"type":"Point","coordinates":[5,4]},"source":"fdb6df62-bce8-6c23-e342-80bd5c938774","provider_type":"uwb","provider_id":"77:4f:34:69:27:40","timestamp_generated":"2019-09-02T22:02:24.355Z"},"name":"Forklift","geometry":{"type":"Polygon","coordinates":[[[4,3],[6,3],[6,5],[4,5],[4,3]]]},"properties":{"org.wavecom.whereis":{"eid":"FL0002"}}}`),
+	},
+}
+
+func TestTrackableMotionMarshal(t *testing.T) {
+	for _, tc := range trackableMotionJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONMarshalOK(t, tc.motion, tc.json)
+		})
+	}
+}
+
+func TestTrackableMotionUnmarshal(t *testing.T) {
+	for _, tc := range trackableMotionJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONUnmarshalOK(t, tc.json, tc.motion)
+		})
+	}
+}
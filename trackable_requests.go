@@ -16,7 +16,7 @@ type TrackablesAPI struct {
 }
 
 // List lists all trackables.
-func (c *TrackablesAPI) List(ctx context.Context) ([]Trackable, error) {
+func (c *TrackablesAPI) List(ctx context.Context, opts ...RequestOption) ([]Trackable, error) {
 	requestPath := "/trackables/summary"
 
 	return sendRequestParseResponseList[Trackable](
@@ -27,11 +27,12 @@ func (c *TrackablesAPI) List(ctx context.Context) ([]Trackable, error) {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // IDs lists all trackable IDs.
-func (c *TrackablesAPI) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (c *TrackablesAPI) IDs(ctx context.Context, opts ...RequestOption) ([]uuid.UUID, error) {
 	requestPath := "/trackables"
 
 	return sendRequestParseResponseList[uuid.UUID](
@@ -42,11 +43,12 @@ func (c *TrackablesAPI) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // Create creates a trackable.
-func (c *TrackablesAPI) Create(ctx context.Context, trackable Trackable) (*Trackable, error) {
+func (c *TrackablesAPI) Create(ctx context.Context, trackable Trackable, opts ...RequestOption) (*Trackable, error) {
 	requestPath := "/trackables"
 
 	return sendStructuredRequestParseResponse[Trackable](
@@ -57,11 +59,12 @@ func (c *TrackablesAPI) Create(ctx context.Context, trackable Trackable) (*Track
 		trackable,
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // DeleteAll deletes all trackables.
-func (c *TrackablesAPI) DeleteAll(ctx context.Context) error {
+func (c *TrackablesAPI) DeleteAll(ctx context.Context, opts ...RequestOption) error {
 	requestPath := "/trackables"
 
 	_, err := sendRequestParseResponse[struct{}](
@@ -72,13 +75,14 @@ func (c *TrackablesAPI) DeleteAll(ctx context.Context) error {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 
 	return err
 }
 
 // Get gets a trackable.
-func (c *TrackablesAPI) Get(ctx context.Context, id uuid.UUID) (*Trackable, error) {
+func (c *TrackablesAPI) Get(ctx context.Context, id uuid.UUID, opts ...RequestOption) (*Trackable, error) {
 	requestPath := "/trackables/" + id.String()
 
 	return sendRequestParseResponse[Trackable](
@@ -89,11 +93,12 @@ func (c *TrackablesAPI) Get(ctx context.Context, id uuid.UUID) (*Trackable, erro
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 }
 
 // Delete deletes a trackable.
-func (c *TrackablesAPI) Delete(ctx context.Context, id uuid.UUID) error {
+func (c *TrackablesAPI) Delete(ctx context.Context, id uuid.UUID, opts ...RequestOption) error {
 	requestPath := "/trackables/" + id.String()
 
 	_, err := sendRequestParseResponse[struct{}](
@@ -104,13 +109,14 @@ func (c *TrackablesAPI) Delete(ctx context.Context, id uuid.UUID) error {
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 
 	return err
 }
 
 // Update updates a trackable.
-func (c *TrackablesAPI) Update(ctx context.Context, trackable Trackable, id uuid.UUID) error {
+func (c *TrackablesAPI) Update(ctx context.Context, trackable Trackable, id uuid.UUID, opts ...RequestOption) error {
 	requestPath := "/trackables/" + id.String()
 
 	_, err := sendStructuredRequestParseResponse[struct{}](
@@ -121,6 +127,7 @@ func (c *TrackablesAPI) Update(ctx context.Context, trackable Trackable, id uuid
 		trackable,
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
 	)
 
 	return err
@@ -128,7 +135,7 @@ func (c *TrackablesAPI) Update(ctx context.Context, trackable Trackable, id uuid
 
 // GetLocation gets the last most recent location for a trackable.
 // It considers all recent location updates of the trackables location providers.
-func (c *TrackablesAPI) GetLocation(ctx context.Context, id uuid.UUID) (*Location, error) {
+func (c *TrackablesAPI) GetLocation(ctx context.Context, id uuid.UUID, opts ...RequestOption) (*Location, error) {
 	requestPath := "/trackables/" + id.String() + "/location"
 
 	return sendRequestParseResponse[Location](
@@ -139,5 +146,86 @@ func (c *TrackablesAPI) GetLocation(ctx context.Context, id uuid.UUID) (*Locatio
 		nil, // request body
 		nil, // request query parameters
 		nil, // request headers
+		opts...,
+	)
+}
+
+// Providers lists the location providers assigned to a trackable.
+func (c *TrackablesAPI) Providers(ctx context.Context, id uuid.UUID, opts ...RequestOption) ([]LocationProvider, error) {
+	requestPath := "/trackables/" + id.String() + "/providers"
+
+	return sendRequestParseResponseList[LocationProvider](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Fences lists the fences a trackable is currently within.
+func (c *TrackablesAPI) Fences(ctx context.Context, id uuid.UUID, opts ...RequestOption) ([]Fence, error) {
+	requestPath := "/trackables/" + id.String() + "/fences"
+
+	return sendRequestParseResponseList[Fence](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Locations lists the last most recent locations of all location providers of a trackable.
+func (c *TrackablesAPI) Locations(ctx context.Context, id uuid.UUID, opts ...RequestOption) ([]Location, error) {
+	requestPath := "/trackables/" + id.String() + "/locations"
+
+	return sendRequestParseResponseList[Location](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// GetMotion gets the last most recent motion of a trackable.
+func (c *TrackablesAPI) GetMotion(ctx context.Context, id uuid.UUID, opts ...RequestOption) (*TrackableMotion, error) {
+	requestPath := "/trackables/" + id.String() + "/motion"
+
+	return sendRequestParseResponse[TrackableMotion](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Motions lists the last most recent motions of all trackables.
+func (c *TrackablesAPI) Motions(ctx context.Context, opts ...RequestOption) ([]TrackableMotion, error) {
+	requestPath := "/trackables/motions"
+
+	return sendRequestParseResponseList[TrackableMotion](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
 	)
 }
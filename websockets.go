@@ -57,6 +57,10 @@ func (p Parameters) LogValue() slog.Value {
 	logv := make([]slog.Attr, 0, len(p))
 
 	for name, val := range p {
+		// never log credentials
+		if name == ParamAccessToken {
+			val = "REDACTED"
+		}
 		logv = append(logv, slog.String(name, val))
 	}
 
@@ -133,6 +137,10 @@ const (
 	// To retrieve location information as GeoJson feature collection.
 	TopicLocationUpdatesGeoJSON Topic = "location_updates:geojson"
 
+	// For sending proximity updates to the hub (e.g. from RFID readers or iBeacon receivers).
+	// When sending data for this topic the payload of the wrapper object contains omlox™ Proximity objects.
+	TopicProximityUpdates Topic = "proximity_updates"
+
 	// Checks trackable movements for collisions and sends collision events when trackables:
 	// start to collide, continue to collide and end a collision.
 	// When receiving data for this topic the payload of the wrapper object contains omlox™ CollisionEvent objects.
@@ -0,0 +1,69 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Zone defines model for Zone.
+//
+//easyjson:json
+type Zone struct {
+	// Must be a UUID. When creating a zone, a unique id will be generated if it is not provided.
+	ID uuid.UUID `json:"id"`
+
+	// The location provider technology used by the RTLS system of this zone (e.g. 'uwb', 'wifi', 'rfid', 'ibeacon').
+	Type LocationProviderType `json:"type"`
+
+	// The unique identifier of the RTLS system (e.g. the source of location updates generated by the zone).
+	ForeignID string `json:"foreign_id,omitempty"`
+
+	// A describing name.
+	Name string `json:"name,omitempty"`
+
+	// A description of the zone.
+	Description string `json:"description,omitempty"`
+
+	// A GeoJson Point geometry. The position of a zone with a local coordinate system, or the position of a
+	// proximity zone (e.g. an RFID reader or an iBeacon) given in WGS84.
+	Position *Point `json:"position,omitempty"`
+
+	// A radius provided in meters, defining the approximate circumference of a proximity zone.
+	// Must be a positive number.
+	Radius float64 `json:"radius,omitempty"`
+
+	// The ground control points (GCPs) used to compute the transformation of the local zone coordinate system
+	// to WGS84. At least two points are required to determine the transformation (see [Zone.Transform]).
+	GroundControlPoints []GroundControlPoint `json:"ground_control_points,omitempty"`
+
+	// Set to true when the zone configuration is incomplete (e.g. it is missing ground control points) and
+	// the zone can not yet be used for transforming location updates.
+	IncompleteConfiguration bool `json:"incomplete_configuration,omitempty"`
+
+	// The timestamp when the ground control points were measured.
+	// The timestamp MUST be an ISO 8601 timestamp using UTC timezone.
+	MeasurementTimestamp *time.Time `json:"measurement_timestamp,omitempty"`
+
+	// A logical and non-localized representation for a building floor. Floor 0 represents the floor designated as 'ground'.
+	Floor float64 `json:"floor,omitempty"`
+
+	// Any additional application or vendor specific properties.
+	// An application implementing this object is not required to interpret any of the custom properties,
+	// but it MUST preserve the properties if set.
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
+
+// GroundControlPoint defines a reference point measured in both the local zone
+// coordinate system and in WGS84 (longitude, latitude).
+type GroundControlPoint struct {
+	// The position of the point in WGS84 coordinates (longitude, latitude).
+	Wgs84 Point `json:"wgs84"`
+
+	// The position of the point in the local zone coordinate system (x, y).
+	Local Point `json:"local"`
+}
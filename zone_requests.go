@@ -0,0 +1,151 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// ZonesAPI is a simple wrapper around the client for zone requests.
+type ZonesAPI struct {
+	client *Client
+}
+
+// List lists all zones.
+func (c *ZonesAPI) List(ctx context.Context, opts ...RequestOption) ([]Zone, error) {
+	requestPath := "/zones/summary"
+
+	return sendRequestParseResponseList[Zone](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// IDs lists all zone IDs.
+func (c *ZonesAPI) IDs(ctx context.Context, opts ...RequestOption) ([]uuid.UUID, error) {
+	requestPath := "/zones"
+
+	return sendRequestParseResponseList[uuid.UUID](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Create creates a zone.
+func (c *ZonesAPI) Create(ctx context.Context, zone Zone, opts ...RequestOption) (*Zone, error) {
+	requestPath := "/zones"
+
+	return sendStructuredRequestParseResponse[Zone](
+		ctx,
+		c.client,
+		http.MethodPost,
+		requestPath,
+		zone,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// DeleteAll deletes all zones.
+func (c *ZonesAPI) DeleteAll(ctx context.Context, opts ...RequestOption) error {
+	requestPath := "/zones"
+
+	_, err := sendRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodDelete,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Get gets a zone.
+func (c *ZonesAPI) Get(ctx context.Context, id uuid.UUID, opts ...RequestOption) (*Zone, error) {
+	requestPath := "/zones/" + id.String()
+
+	return sendRequestParseResponse[Zone](
+		ctx,
+		c.client,
+		http.MethodGet,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
+
+// Delete deletes a zone.
+func (c *ZonesAPI) Delete(ctx context.Context, id uuid.UUID, opts ...RequestOption) error {
+	requestPath := "/zones/" + id.String()
+
+	_, err := sendRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodDelete,
+		requestPath,
+		nil, // request body
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Update updates a zone.
+func (c *ZonesAPI) Update(ctx context.Context, zone Zone, id uuid.UUID, opts ...RequestOption) error {
+	requestPath := "/zones/" + id.String()
+
+	_, err := sendStructuredRequestParseResponse[struct{}](
+		ctx,
+		c.client,
+		http.MethodPut,
+		requestPath,
+		zone,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+
+	return err
+}
+
+// Transform transforms a location in the local coordinate system of a zone to WGS84 on the hub.
+// To transform locations without a request per location, see [Zone.Transform].
+func (c *ZonesAPI) Transform(ctx context.Context, location Location, id uuid.UUID, opts ...RequestOption) (*Location, error) {
+	requestPath := "/zones/" + id.String() + "/transform"
+
+	return sendStructuredRequestParseResponse[Location](
+		ctx,
+		c.client,
+		http.MethodPut,
+		requestPath,
+		location,
+		nil, // request query parameters
+		nil, // request headers
+		opts...,
+	)
+}
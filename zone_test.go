@@ -0,0 +1,73 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/geojson/geometry"
+)
+
+var zonesJSONTestCases = []struct {
+	name string
+	zone Zone
+	json []byte
+}{
+	{
+		name: "required",
+		zone: Zone{
+			ID:   uuid.MustParse("f4c05a2b-afd3-41a0-88e2-46f69bdb192e"),
+			Type: LocationProviderTypeUwb,
+		},
+		json: []byte(`{"id":"f4c05a2b-afd3-41a0-88e2-46f69bdb192e","type":"uwb"}`),
+	},
+	{
+		name: "fully-populated",
+		zone: Zone{
+			ID:          uuid.MustParse("f4c05a2b-afd3-41a0-88e2-46f69bdb192e"),
+			Type:        LocationProviderTypeUwb,
+			ForeignID:   "sewio-rtls-01",
+			Name:        "Warehouse",
+			Description: "Main warehouse UWB zone",
+			Position:    NewPoint(geometry.Point{X: 7.815694, Y: 48.13021599999995}),
+			GroundControlPoints: []GroundControlPoint{
+				{
+					Wgs84: *NewPoint(geometry.Point{X: 7.815694, Y: 48.13021599999995}),
+					Local: *NewPoint(geometry.Point{X: 0, Y: 0}),
+				},
+				{
+					Wgs84: *NewPoint(geometry.Point{X: 7.816582, Y: 48.13018799999995}),
+					Local: *NewPoint(geometry.Point{X: 66.1, Y: 0}),
+				},
+				{
+					Wgs84: *NewPoint(geometry.Point{X: 7.815724999999997, Y: 48.13031}),
+					Local: *NewPoint(geometry.Point{X: 0, Y: 10.5}),
+				},
+			},
+			IncompleteConfiguration: true,
+			MeasurementTimestamp:    mustParseTime("2023-10-17T11:14:37.206Z"),
+			Floor:                   1,
+			Properties:              json.RawMessage(`{"org.wavecom.whereis":{"site":"PT01"}}`),
+		},
+		json: []byte(`{"id":"f4c05a2b-afd3-41a0-88e2-46f69bdb192e","type":"uwb","foreign_id":"sewio-rtls-01","name":"Warehouse","description":"Main warehouse UWB zone","position":{"type":"Point","coordinates":[7.815694,48.13021599999995]},"ground_control_points":[{"wgs84":{"type":"Point","coordinates":[7.815694,48.13021599999995]},"local":{"type":"Point","coordinates":[0,0]}},{"wgs84":{"type":"Point","coordinates":[7.816582,48.13018799999995]},"local":{"type":"Point","coordinates":[66.1,0]}},{"wgs84":{"type":"Point","coordinates":[7.815724999999997,48.13031]},"local":{"type":"Point","coordinates":[0,10.5]}}],"incomplete_configuration":true,"measurement_timestamp":"2023-10-17T11:14:37.206Z","floor":1,"properties":{"org.wavecom.whereis":{"site":"PT01"}}}`),
+	},
+}
+
+func TestZoneMarshal(t *testing.T) {
+	for _, tc := range zonesJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONMarshalOK(t, tc.zone, tc.json)
+		})
+	}
+}
+
+func TestZoneUnmarshal(t *testing.T) {
+	for _, tc := range zonesJSONTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			JSONUnmarshalOK(t, tc.json, tc.zone)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tidwall/geojson/geometry"
+)
+
+const (
+	// WGS84 semi-major axis in meters.
+	earthRadius = 6378137.0
+
+	// relative tolerance used to detect degenerate ground control points configurations,
+	// so that it does not depend on the unit and magnitude of the coordinates.
+	transformEpsilon = 1e-9
+)
+
+// Errors
+var (
+	ErrNotEnoughControlPoints  = errors.New("at least two ground control points are required")
+	ErrDegenerateControlPoints = errors.New("ground control points are degenerate (e.g. coincident or collinear)")
+)
+
+// ZoneTransform converts coordinates between the local coordinate system of a zone and WGS84.
+//
+// The transformation is computed on the client side from the zone ground control points.
+// WGS84 coordinates are projected to a local tangent plane (in meters) centered on the
+// ground control points, where a similarity transformation (two points) or a least squares
+// affine transformation (three or more points) is fitted to the local coordinates.
+// This is accurate for the extent of a typical indoor or campus zone.
+//
+// A ZoneTransform is immutable and safe for concurrent use.
+type ZoneTransform struct {
+	// origin of the local tangent plane (longitude, latitude).
+	origin geometry.Point
+	// meters per degree of longitude and latitude at the origin.
+	mx, my float64
+
+	// forward affine transformation: local -> tangent plane.
+	// e = a[0]*x + a[1]*y + a[2]
+	// n = a[3]*x + a[4]*y + a[5]
+	a [6]float64
+
+	// inverse affine transformation: tangent plane -> local.
+	inv [6]float64
+}
+
+// NewZoneTransform computes the transformation between a zone local coordinate system
+// and WGS84 from the given ground control points.
+func NewZoneTransform(gcps []GroundControlPoint) (*ZoneTransform, error) {
+	if len(gcps) < 2 {
+		return nil, ErrNotEnoughControlPoints
+	}
+
+	// use the centroid of the WGS84 coordinates as the tangent plane origin
+	var origin geometry.Point
+	for _, gcp := range gcps {
+		p := gcp.Wgs84.Base()
+		origin.X += p.X
+		origin.Y += p.Y
+	}
+	origin.X /= float64(len(gcps))
+	origin.Y /= float64(len(gcps))
+
+	t := &ZoneTransform{
+		origin: origin,
+		mx:     earthRadius * math.Pi / 180 * math.Cos(origin.Y*math.Pi/180),
+		my:     earthRadius * math.Pi / 180,
+	}
+
+	src := make([]geometry.Point, len(gcps))
+	dst := make([]geometry.Point, len(gcps))
+	for i, gcp := range gcps {
+		src[i] = gcp.Local.Base()
+		dst[i] = t.project(gcp.Wgs84.Base())
+	}
+
+	var err error
+	if len(gcps) == 2 {
+		t.a, err = fitSimilarity(src, dst)
+	} else {
+		t.a, err = fitAffine(src, dst)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t.inv, err = invertAffine(t.a)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Transform returns the transformation between the zone local coordinate system and WGS84,
+// computed from the zone ground control points.
+func (z Zone) Transform() (*ZoneTransform, error) {
+	return NewZoneTransform(z.GroundControlPoints)
+}
+
+// ToWGS84 converts a point in the zone local coordinate system to WGS84 (longitude, latitude).
+func (t *ZoneTransform) ToWGS84(p geometry.Point) geometry.Point {
+	return t.unproject(applyAffine(t.a, p))
+}
+
+// ToLocal converts a WGS84 point (longitude, latitude) to the zone local coordinate system.
+func (t *ZoneTransform) ToLocal(p geometry.Point) geometry.Point {
+	return applyAffine(t.inv, t.project(p))
+}
+
+// TransformLocation converts the location position between the zone local coordinate system and WGS84.
+// Locations in the 'local' crs (or without crs) are converted to WGS84 and WGS84 locations are converted
+// to the local coordinate system. Other projections are not supported.
+// The z component of the position is preserved, if any.
+func (t *ZoneTransform) TransformLocation(l Location) (Location, error) {
+	var conv func(geometry.Point) geometry.Point
+
+	switch l.Crs {
+	case "", CrsLocal:
+		conv, l.Crs = t.ToWGS84, CrsWGS84
+	case CrsWGS84:
+		conv, l.Crs = t.ToLocal, CrsLocal
+	default:
+		return l, errors.New("unsupported location crs: " + l.Crs)
+	}
+
+	p := conv(l.Position.Base())
+	if l.Position.hasZ() {
+		l.Position = *NewPointZ(p, l.Position.Z())
+	} else {
+		l.Position = *NewPoint(p)
+	}
+
+	return l, nil
+}
+
+// project converts WGS84 coordinates to the local tangent plane (east, north) in meters.
+func (t *ZoneTransform) project(p geometry.Point) geometry.Point {
+	return geometry.Point{
+		X: (p.X - t.origin.X) * t.mx,
+		Y: (p.Y - t.origin.Y) * t.my,
+	}
+}
+
+// unproject converts local tangent plane coordinates (east, north) to WGS84.
+func (t *ZoneTransform) unproject(p geometry.Point) geometry.Point {
+	return geometry.Point{
+		X: t.origin.X + p.X/t.mx,
+		Y: t.origin.Y + p.Y/t.my,
+	}
+}
+
+// fitSimilarity computes the similarity transformation (rotation, uniform scale and translation)
+// that maps the two src points to the two dst points.
+func fitSimilarity(src, dst []geometry.Point) ([6]float64, error) {
+	sx, sy := src[1].X-src[0].X, src[1].Y-src[0].Y
+	dx, dy := dst[1].X-dst[0].X, dst[1].Y-dst[0].Y
+
+	// the points must be apart relative to the magnitude of their coordinates
+	scale := max(math.Abs(src[0].X), math.Abs(src[0].Y), math.Abs(src[1].X), math.Abs(src[1].Y))
+
+	d := sx*sx + sy*sy
+	if math.Sqrt(d) <= transformEpsilon*scale {
+		return [6]float64{}, ErrDegenerateControlPoints
+	}
+
+	// complex division (dx + i*dy) / (sx + i*sy) gives the scaled rotation
+	a := (dx*sx + dy*sy) / d
+	b := (dy*sx - dx*sy) / d
+
+	return [6]float64{
+		a, -b, dst[0].X - (a*src[0].X - b*src[0].Y),
+		b, a, dst[0].Y - (b*src[0].X + a*src[0].Y),
+	}, nil
+}
+
+// fitAffine computes the least squares affine transformation that maps the src points to the dst points.
+func fitAffine(src, dst []geometry.Point) ([6]float64, error) {
+	// the src points are centered on their centroid, so that the normal equations
+	// are well conditioned even if the local coordinates are far from their origin
+	var c geometry.Point
+	for _, p := range src {
+		c.X += p.X
+		c.Y += p.Y
+	}
+	c.X /= float64(len(src))
+	c.Y /= float64(len(src))
+
+	// normal equations: (AᵀA) p = Aᵀb, where each row of A is [x y 1]
+	var (
+		ata  [3][3]float64
+		atbX [3]float64
+		atbY [3]float64
+	)
+
+	for i := range src {
+		row := [3]float64{src[i].X - c.X, src[i].Y - c.Y, 1}
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				ata[j][k] += row[j] * row[k]
+			}
+			atbX[j] += row[j] * dst[i].X
+			atbY[j] += row[j] * dst[i].Y
+		}
+	}
+
+	px, err := solve3(ata, atbX)
+	if err != nil {
+		return [6]float64{}, err
+	}
+
+	py, err := solve3(ata, atbY)
+	if err != nil {
+		return [6]float64{}, err
+	}
+
+	// undo the centering in the translation terms
+	return [6]float64{
+		px[0], px[1], px[2] - px[0]*c.X - px[1]*c.Y,
+		py[0], py[1], py[2] - py[0]*c.X - py[1]*c.Y,
+	}, nil
+}
+
+// solve3 solves the 3x3 linear system m·x = b using Cramer's rule.
+func solve3(m [3][3]float64, b [3]float64) ([3]float64, error) {
+	// the determinant is compared to its upper bound, the product of the row norms (Hadamard's inequality)
+	bound := 1.0
+	for _, row := range m {
+		bound *= math.Sqrt(row[0]*row[0] + row[1]*row[1] + row[2]*row[2])
+	}
+
+	det := det3(m)
+	if math.Abs(det) <= transformEpsilon*bound {
+		return [3]float64{}, ErrDegenerateControlPoints
+	}
+
+	var x [3]float64
+	for i := 0; i < 3; i++ {
+		mi := m
+		for j := 0; j < 3; j++ {
+			mi[j][i] = b[j]
+		}
+		x[i] = det3(mi) / det
+	}
+
+	return x, nil
+}
+
+func det3(m [3][3]float64) float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// invertAffine returns the inverse of the given affine transformation.
+func invertAffine(a [6]float64) ([6]float64, error) {
+	// the determinant is compared to its upper bound, the product of the row norms (Hadamard's inequality)
+	det := a[0]*a[4] - a[1]*a[3]
+	if math.Abs(det) <= transformEpsilon*math.Hypot(a[0], a[1])*math.Hypot(a[3], a[4]) {
+		return [6]float64{}, ErrDegenerateControlPoints
+	}
+
+	i0, i1 := a[4]/det, -a[1]/det
+	i3, i4 := -a[3]/det, a[0]/det
+
+	return [6]float64{
+		i0, i1, -(i0*a[2] + i1*a[5]),
+		i3, i4, -(i3*a[2] + i4*a[5]),
+	}, nil
+}
+
+func applyAffine(a [6]float64, p geometry.Point) geometry.Point {
+	return geometry.Point{
+		X: a[0]*p.X + a[1]*p.Y + a[2],
+		Y: a[3]*p.X + a[4]*p.Y + a[5],
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) Omlox Client Go Contributors
+// SPDX-License-Identifier: MIT
+
+package omlox
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/tidwall/geojson/geometry"
+)
+
+// testZoneOrigin is the WGS84 position of the local zone origin used in the transformation tests.
+var testZoneOrigin = geometry.Point{X: 7.815694, Y: 48.130216}
+
+// testLocalToWGS84 converts a local point to WGS84 with the zone local axes
+// rotated by rot radians counter-clockwise from east.
+func testLocalToWGS84(p geometry.Point, rot float64) geometry.Point {
+	mx := earthRadius * math.Pi / 180 * math.Cos(testZoneOrigin.Y*math.Pi/180)
+	my := earthRadius * math.Pi / 180
+
+	e := p.X*math.Cos(rot) - p.Y*math.Sin(rot)
+	n := p.X*math.Sin(rot) + p.Y*math.Cos(rot)
+
+	return geometry.Point{X: testZoneOrigin.X + e/mx, Y: testZoneOrigin.Y + n/my}
+}
+
+func testGCPs(rot float64, locals ...geometry.Point) []GroundControlPoint {
+	gcps := make([]GroundControlPoint, 0, len(locals))
+	for _, p := range locals {
+		gcps = append(gcps, GroundControlPoint{
+			Wgs84: *NewPoint(testLocalToWGS84(p, rot)),
+			Local: *NewPoint(p),
+		})
+	}
+	return gcps
+}
+
+func pointsNear(a, b geometry.Point, tolerance float64) bool {
+	return math.Abs(a.X-b.X) <= tolerance && math.Abs(a.Y-b.Y) <= tolerance
+}
+
+func TestZoneTransform(t *testing.T) {
+	rot := math.Pi / 6
+
+	cases := []struct {
+		name string
+		gcps []GroundControlPoint
+	}{
+		{"similarity", testGCPs(rot, geometry.Point{X: 0, Y: 0}, geometry.Point{X: 80, Y: 0})},
+		{"affine", testGCPs(rot, geometry.Point{X: 0, Y: 0}, geometry.Point{X: 80, Y: 0}, geometry.Point{X: 0, Y: 40})},
+		{"least-squares", testGCPs(rot, geometry.Point{X: 0, Y: 0}, geometry.Point{X: 80, Y: 0}, geometry.Point{X: 0, Y: 40}, geometry.Point{X: 80, Y: 40})},
+	}
+
+	local := geometry.Point{X: 23.4, Y: 17.8}
+	wgs84 := testLocalToWGS84(local, rot)
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tr, err := NewZoneTransform(tc.gcps)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// ~1cm in degrees
+			if got := tr.ToWGS84(local); !pointsNear(got, wgs84, 1e-7) {
+				t.Errorf("ToWGS84(%v) = %v, want %v", local, got, wgs84)
+			}
+
+			if got := tr.ToLocal(wgs84); !pointsNear(got, local, 1e-2) {
+				t.Errorf("ToLocal(%v) = %v, want %v", wgs84, got, local)
+			}
+		})
+	}
+}
+
+func TestZoneTransformErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		gcps []GroundControlPoint
+		err  error
+	}{
+		{"empty", nil, ErrNotEnoughControlPoints},
+		{"single", testGCPs(0, geometry.Point{X: 0, Y: 0}), ErrNotEnoughControlPoints},
+		{"two", testGCPs(0, geometry.Point{X: 0, Y: 0}, geometry.Point{X: 1, Y: 1}), nil},
+		{"coincident", testGCPs(0, geometry.Point{X: 1, Y: 1}, geometry.Point{X: 1, Y: 1}), ErrDegenerateControlPoints},
+		{"collinear", testGCPs(0, geometry.Point{X: 0, Y: 0}, geometry.Point{X: 1, Y: 1}, geometry.Point{X: 2, Y: 2}), ErrDegenerateControlPoints},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewZoneTransform(tc.gcps); !errors.Is(err, tc.err) {
+				t.Errorf("got error %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestZoneTransformScale(t *testing.T) {
+	rot := math.Pi / 6
+
+	cases := []struct {
+		name string
+		// local units per meter and position of the zone in local units
+		scale  float64
+		offset geometry.Point
+	}{
+		{"millimeters", 1e3, geometry.Point{}},
+		{"projected-meters", 1, geometry.Point{X: 500000, Y: 5330000}},
+		{"projected-millimeters", 1e3, geometry.Point{X: 500000e3, Y: 5330000e3}},
+		{"kilometers", 1e-3, geometry.Point{}},
+		{"micro-units", 1e-6, geometry.Point{}},
+	}
+
+	// local coordinates in meters, converted to the scale of each case
+	meters := []geometry.Point{{X: 0, Y: 0}, {X: 80, Y: 0}, {X: 0, Y: 40}, {X: 80, Y: 40}}
+	local := geometry.Point{X: 23.4, Y: 17.8}
+	wgs84 := testLocalToWGS84(local, rot)
+
+	for _, tc := range cases {
+		scaled := func(p geometry.Point) geometry.Point {
+			return geometry.Point{X: p.X*tc.scale + tc.offset.X, Y: p.Y*tc.scale + tc.offset.Y}
+		}
+
+		for _, n := range []int{2, 3, 4} {
+			t.Run(fmt.Sprintf("%s/%d", tc.name, n), func(t *testing.T) {
+				gcps := testGCPs(rot, meters[:n]...)
+				for i := range gcps {
+					gcps[i].Local = *NewPoint(scaled(meters[i]))
+				}
+
+				tr, err := NewZoneTransform(gcps)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got := tr.ToWGS84(scaled(local)); !pointsNear(got, wgs84, 1e-7) {
+					t.Errorf("ToWGS84(%v) = %v, want %v", scaled(local), got, wgs84)
+				}
+
+				if got := tr.ToLocal(wgs84); !pointsNear(got, scaled(local), 1e-2*tc.scale) {
+					t.Errorf("ToLocal(%v) = %v, want %v", wgs84, got, scaled(local))
+				}
+			})
+		}
+
+		t.Run(tc.name+"/collinear", func(t *testing.T) {
+			collinear := []geometry.Point{{X: 0, Y: 0}, {X: 10.1, Y: 10.1}, {X: 30.7, Y: 30.7}}
+
+			gcps := testGCPs(rot, collinear...)
+			for i := range gcps {
+				gcps[i].Local = *NewPoint(scaled(collinear[i]))
+			}
+
+			if _, err := NewZoneTransform(gcps); !errors.Is(err, ErrDegenerateControlPoints) {
+				t.Errorf("got error %v, want %v", err, ErrDegenerateControlPoints)
+			}
+		})
+	}
+}
+
+func TestLocationTransform(t *testing.T) {
+	zone := Zone{
+		GroundControlPoints: testGCPs(0, geometry.Point{X: 0, Y: 0}, geometry.Point{X: 50, Y: 0}, geometry.Point{X: 0, Y: 50}),
+	}
+
+	local := Location{
+		Position: *NewPointZ(geometry.Point{X: 10, Y: 5}, 1.5),
+		Crs:      CrsLocal,
+	}
+
+	wgs84, err := local.Transform(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wgs84.Crs != CrsWGS84 {
+		t.Errorf("crs = %q, want %q", wgs84.Crs, CrsWGS84)
+	}
+
+	if want := testLocalToWGS84(local.Position.Base(), 0); !pointsNear(wgs84.Position.Base(), want, 1e-7) {
+		t.Errorf("position = %v, want %v", wgs84.Position.Base(), want)
+	}
+
+	if z := wgs84.Position.Z(); z != 1.5 {
+		t.Errorf("z = %v, want 1.5", z)
+	}
+
+	back, err := wgs84.Transform(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if back.Crs != CrsLocal || !pointsNear(back.Position.Base(), local.Position.Base(), 1e-2) {
+		t.Errorf("round trip = %v (%s), want %v (%s)", back.Position.Base(), back.Crs, local.Position.Base(), local.Crs)
+	}
+
+	// the position keeps its dimensions, even if z is zero
+	for _, pos := range []Point{*NewPoint(geometry.Point{X: 10, Y: 5}), *NewPointZ(geometry.Point{X: 10, Y: 5}, 0)} {
+		l, err := (Location{Position: pos, Crs: CrsLocal}).Transform(zone)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got, want := l.Position.hasZ(), pos.hasZ(); got != want {
+			t.Errorf("transformed position %s has z = %t, want %t", l.Position.JSON(), got, want)
+		}
+	}
+
+	if _, err := (Location{Crs: "EPSG:25832"}).Transform(zone); err == nil {
+		t.Error("expected error for unsupported crs")
+	}
+}